@@ -22,8 +22,14 @@ func main() {
 		os.Exit(1)
 	}
 
+	m, err := tui.New(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
 	p := tea.NewProgram(
-		tui.New(cfg),
+		m,
 		tea.WithAltScreen(),
 	)
 
@@ -0,0 +1,102 @@
+package bgg
+
+import (
+	"context"
+	"sync"
+)
+
+// maxThingIDs is the number of IDs the thing endpoint accepts per request.
+const maxThingIDs = 20
+
+// DefaultBatchConcurrency is the number of batches GetGamesAll requests in
+// parallel when BatchOptions.Concurrency is not set.
+const DefaultBatchConcurrency = 4
+
+// BatchOptions controls how GetGamesAll splits and runs its requests.
+type BatchOptions struct {
+	// Concurrency is the maximum number of batches in flight (default:
+	// DefaultBatchConcurrency). Requests still go through the rate limiter.
+	Concurrency int
+}
+
+// GetGamesAll retrieves detailed information about any number of games.
+// Duplicate IDs are dropped and the rest are requested in batches of 20,
+// several at a time. Games are returned in the order their IDs first appear;
+// IDs BGG does not know are skipped.
+//
+// If some batches fail, the games from the others are still returned along
+// with a *BatchError listing the failed batches.
+func (c *Client) GetGamesAll(ctx context.Context, ids []int, opts BatchOptions) ([]Game, error) {
+	unique := dedupeIDs(ids)
+
+	var batches [][]int
+	for i := 0; i < len(unique); i += maxThingIDs {
+		end := min(i+maxThingIDs, len(unique))
+		batches = append(batches, unique[i:end])
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	results := make([][]Game, len(batches))
+	errs := make([]error, len(batches))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, batch := range batches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				errs[i] = newNetworkError("request canceled", 0, ctx.Err())
+				return
+			}
+			defer func() { <-sem }()
+			results[i], errs[i] = c.GetGamesContext(ctx, batch)
+		}()
+	}
+	wg.Wait()
+
+	byID := make(map[int]Game, len(unique))
+	var batchErr *BatchError
+	for i, games := range results {
+		if errs[i] != nil {
+			if batchErr == nil {
+				batchErr = &BatchError{Batches: len(batches)}
+			}
+			batchErr.Failures = append(batchErr.Failures, BatchFailure{IDs: batches[i], Err: errs[i]})
+			continue
+		}
+		for _, g := range games {
+			byID[g.ID] = g
+		}
+	}
+
+	games := make([]Game, 0, len(byID))
+	for _, id := range unique {
+		if g, ok := byID[id]; ok {
+			games = append(games, g)
+		}
+	}
+
+	if batchErr != nil {
+		return games, batchErr
+	}
+	return games, nil
+}
+
+// dedupeIDs returns ids without duplicates, keeping the first occurrence.
+func dedupeIDs(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	unique := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
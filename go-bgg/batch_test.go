@@ -0,0 +1,172 @@
+package bgg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newBatchServer returns a server that answers /thing with one item per
+// requested ID, failing any batch that contains failID.
+func newBatchServer(t *testing.T, failID int) (*httptest.Server, *[][]int, *int32) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var batches [][]int
+	var inFlight, maxInFlight int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		var ids []int
+		for _, s := range strings.Split(r.URL.Query().Get("id"), ",") {
+			id, _ := strconv.Atoi(s)
+			ids = append(ids, id)
+		}
+		mu.Lock()
+		batches = append(batches, ids)
+		mu.Unlock()
+
+		var b strings.Builder
+		b.WriteString("<items>")
+		for _, id := range ids {
+			if id == failID {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			fmt.Fprintf(&b, `<item type="boardgame" id="%d"><name type="primary" value="Game %d"/></item>`, id, id)
+		}
+		b.WriteString("</items>")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(b.String()))
+	}))
+	t.Cleanup(server.Close)
+	return server, &batches, &maxInFlight
+}
+
+func TestGetGamesAll(t *testing.T) {
+	server, batches, maxInFlight := newBatchServer(t, 0)
+	client := createTestClient(t, server)
+
+	var ids []int
+	for i := 90; i >= 1; i-- {
+		ids = append(ids, i)
+	}
+	ids = append(ids, 5, 50, 90)
+
+	games, err := client.GetGamesAll(context.Background(), ids, BatchOptions{Concurrency: 2})
+	if err != nil {
+		t.Fatalf("GetGamesAll failed: %v", err)
+	}
+
+	if len(games) != 90 {
+		t.Fatalf("expected 90 games, got %d", len(games))
+	}
+	for i, g := range games {
+		if g.ID != 90-i {
+			t.Fatalf("games[%d].ID = %d, want %d", i, g.ID, 90-i)
+		}
+	}
+
+	if len(*batches) != 5 {
+		t.Errorf("expected 5 batches, got %d", len(*batches))
+	}
+	for _, b := range *batches {
+		if len(b) > 20 {
+			t.Errorf("batch of %d IDs exceeds the limit", len(b))
+		}
+	}
+	if m := atomic.LoadInt32(maxInFlight); m > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", m)
+	}
+}
+
+func TestGetGamesAll_CacheStatus(t *testing.T) {
+	server, batches, _ := newBatchServer(t, 0)
+	client := createTestClient(t, server)
+	client.cache = NewMemoryCache()
+
+	var ids []int
+	for i := 1; i <= 100; i++ {
+		ids = append(ids, i)
+	}
+
+	// Batches record into the same status concurrently; run with -race
+	var status CacheStatus
+	if _, err := client.GetGamesAll(WithCacheStatus(context.Background(), &status), ids, BatchOptions{}); err != nil {
+		t.Fatalf("GetGamesAll failed: %v", err)
+	}
+	if status != CacheMiss {
+		t.Errorf("expected CacheMiss on first call, got %v", status)
+	}
+
+	status = CacheDisabled
+	if _, err := client.GetGamesAll(WithCacheStatus(context.Background(), &status), ids, BatchOptions{}); err != nil {
+		t.Fatalf("GetGamesAll failed: %v", err)
+	}
+	if status != CacheHit {
+		t.Errorf("expected CacheHit once every batch is cached, got %v", status)
+	}
+	if len(*batches) != 5 {
+		t.Errorf("expected 5 requests in total, got %d", len(*batches))
+	}
+}
+
+func TestGetGamesAll_PartialFailure(t *testing.T) {
+	server, _, _ := newBatchServer(t, 25)
+	client := createTestClient(t, server)
+
+	ids := make([]int, 50)
+	for i := range ids {
+		ids[i] = i + 1
+	}
+
+	games, err := client.GetGamesAll(context.Background(), ids, BatchOptions{})
+	if len(games) != 30 {
+		t.Errorf("expected 30 games from the successful batches, got %d", len(games))
+	}
+
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected BatchError, got %T", err)
+	}
+	if batchErr.Batches != 3 || len(batchErr.Failures) != 1 {
+		t.Fatalf("unexpected BatchError: %v", batchErr)
+	}
+	if f := batchErr.Failures[0]; len(f.IDs) != 20 || f.IDs[0] != 21 {
+		t.Errorf("unexpected failed batch IDs: %v", f.IDs)
+	}
+
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected errors.As to find NotFoundError in %v", err)
+	}
+}
+
+func TestGetGamesAll_Empty(t *testing.T) {
+	client, _ := NewClient(Config{Token: "test-token"})
+
+	games, err := client.GetGamesAll(context.Background(), nil, BatchOptions{})
+	if err != nil {
+		t.Fatalf("GetGamesAll failed: %v", err)
+	}
+	if len(games) != 0 {
+		t.Errorf("expected 0 games, got %d", len(games))
+	}
+}
@@ -0,0 +1,226 @@
+// Package bggtest provides an in-process fake of the BGG XML API for tests.
+//
+// A Server answers the search, thing, hot, collection, forumlist, forum and
+// thread endpoints with canned fixtures, regardless of the query; only
+// collection requests for expansions get a fixture of their own. Like BGG,
+// the collection endpoint first answers 202 Accepted before returning data.
+// Errors and latency can be injected per endpoint.
+//
+//	srv := bggtest.NewServer()
+//	defer srv.Close()
+//
+//	client, _ := bgg.NewClient(srv.Config())
+//	game, _ := client.GetGame(13)
+package bggtest
+
+import (
+	"embed"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	bgg "github.com/hiroaqii/go-bgg"
+)
+
+//go:embed fixtures/*.xml
+var fixtures embed.FS
+
+// Token is the bearer token returned by Config. Any non-empty token is accepted.
+const Token = "bggtest-token"
+
+// Fixture returns the canned response body for an endpoint path such as
+// "/thing", or nil if there is none.
+func Fixture(path string) []byte {
+	data, err := fixtures.ReadFile("fixtures/" + strings.TrimPrefix(path, "/") + ".xml")
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// fault is an injected error response.
+type fault struct {
+	status    int
+	remaining int // number of responses left; < 0 means forever
+}
+
+// Server is a fake BGG XML API server.
+type Server struct {
+	*httptest.Server
+
+	mu                sync.Mutex
+	responses         map[string][]byte
+	faults            map[string]*fault
+	latency           time.Duration
+	collectionPending int
+	pending           map[string]int
+	requests          []string
+}
+
+// NewServer starts a fake server. The caller must call Close when done.
+func NewServer() *Server {
+	s := &Server{
+		responses:         make(map[string][]byte),
+		faults:            make(map[string]*fault),
+		collectionPending: 1,
+		pending:           make(map[string]int),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Config returns a bgg.Config pointing at the server, with short retry
+// delays and rate limiting disabled so tests run fast.
+func (s *Server) Config() bgg.Config {
+	return bgg.Config{
+		Token:      Token,
+		BaseURL:    s.URL,
+		HTTPClient: s.Client(),
+		RetryDelay: time.Millisecond,
+		RateLimit:  -1,
+	}
+}
+
+// SetResponse replaces the response body for an endpoint path (e.g. "/hot").
+// A "/collection" body is returned for both base games and expansions.
+func (s *Server) SetResponse(path string, body []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.responses[path] = body
+}
+
+// Fail makes every request to path answer with status. An empty path
+// matches all endpoints. 429 responses carry "Retry-After: 0".
+func (s *Server) Fail(path string, status int) {
+	s.FailN(path, status, -1)
+}
+
+// FailN makes the next n requests to path answer with status.
+func (s *Server) FailN(path string, status int, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults[path] = &fault{status: status, remaining: n}
+}
+
+// Reset removes all injected faults, overridden responses and latency.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.responses = make(map[string][]byte)
+	s.faults = make(map[string]*fault)
+	s.latency = 0
+}
+
+// SetLatency delays every response by d.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = d
+}
+
+// SetCollectionPending sets how many 202 Accepted responses each distinct
+// collection request gets before its data (default: 1).
+func (s *Server) SetCollectionPending(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.collectionPending = n
+	s.pending = make(map[string]int)
+}
+
+// Requests returns the request URIs (path and query) received so far.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// RequestCount returns the number of requests received for an endpoint path.
+func (s *Server) RequestCount(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, uri := range s.requests {
+		if uri == path || strings.HasPrefix(uri, path+"?") {
+			n++
+		}
+	}
+	return n
+}
+
+// takeFault returns the status of an injected fault for path, if any.
+func (s *Server) takeFault(path string) int {
+	for _, key := range []string{path, ""} {
+		f, ok := s.faults[key]
+		if !ok {
+			continue
+		}
+		if f.remaining == 0 {
+			delete(s.faults, key)
+			continue
+		}
+		if f.remaining > 0 {
+			f.remaining--
+		}
+		return f.status
+	}
+	return 0
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path
+
+	s.mu.Lock()
+	s.requests = append(s.requests, r.URL.RequestURI())
+	latency := s.latency
+	status := s.takeFault(path)
+	body, overridden := s.responses[path]
+	accepted := false
+	if status == 0 && path == "/collection" && s.pending[r.URL.RawQuery] < s.collectionPending {
+		s.pending[r.URL.RawQuery]++
+		accepted = true
+	}
+	s.mu.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	auth := r.Header.Get("Authorization")
+	token := strings.TrimPrefix(auth, "Bearer ")
+
+	switch {
+	case token == auth || token == "":
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	case status == http.StatusTooManyRequests:
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(status)
+		return
+	case status != 0:
+		w.WriteHeader(status)
+		return
+	case accepted:
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	if !overridden {
+		body = Fixture(path)
+		if path == "/collection" && r.URL.Query().Get("subtype") == string(bgg.ThingBoardGameExpansion) {
+			body = Fixture("/collection_expansions")
+		}
+	}
+	if body == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
@@ -0,0 +1,144 @@
+package bggtest
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	bgg "github.com/hiroaqii/go-bgg"
+)
+
+func newTestClient(t *testing.T, srv *Server) *bgg.Client {
+	t.Helper()
+
+	client, err := bgg.NewClient(srv.Config())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return client
+}
+
+func TestServer_Fixtures(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := newTestClient(t, srv)
+
+	results, err := client.SearchGames("catan")
+	if err != nil || len(results) == 0 {
+		t.Errorf("SearchGames() = %d results, %v", len(results), err)
+	}
+
+	game, err := client.GetGame(13)
+	if err != nil || game.Name != "CATAN" {
+		t.Errorf("GetGame() = %+v, %v", game, err)
+	}
+
+	hot, err := client.GetHotGames()
+	if err != nil || len(hot) == 0 {
+		t.Errorf("GetHotGames() = %d games, %v", len(hot), err)
+	}
+
+	forums, err := client.GetForums(13)
+	if err != nil || len(forums) == 0 {
+		t.Errorf("GetForums() = %d forums, %v", len(forums), err)
+	}
+
+	threads, err := client.GetForumThreads(forums[0].ID, 1)
+	if err != nil || len(threads.Threads) == 0 {
+		t.Errorf("GetForumThreads() = %+v, %v", threads, err)
+	}
+
+	thread, err := client.GetThread(threads.Threads[0].ID)
+	if err != nil || len(thread.Articles) == 0 {
+		t.Errorf("GetThread() = %+v, %v", thread, err)
+	}
+}
+
+func TestServer_Collection202(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := newTestClient(t, srv)
+
+	items, err := client.GetCollection("testuser", bgg.CollectionOptions{})
+	if err != nil {
+		t.Fatalf("GetCollection() error = %v", err)
+	}
+	if len(items) != 3 {
+		t.Errorf("expected 3 items, got %d", len(items))
+	}
+	if n := srv.RequestCount("/collection"); n != 2 {
+		t.Errorf("expected 202 then 200 (2 requests), got %d", n)
+	}
+}
+
+func TestServer_CollectionExpansions(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.SetCollectionPending(0)
+	client := newTestClient(t, srv)
+
+	items, err := client.GetCollection("testuser", bgg.CollectionOptions{SplitExpansions: true})
+	if err != nil {
+		t.Fatalf("GetCollection() error = %v", err)
+	}
+	if len(items) != 4 || items[3].Subtype != "boardgameexpansion" {
+		t.Errorf("expected 3 base games and 1 expansion, got %+v", items)
+	}
+}
+
+func TestServer_Faults(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := newTestClient(t, srv)
+
+	srv.Fail("/thing", http.StatusNotFound)
+	var notFound *bgg.NotFoundError
+	if _, err := client.GetGame(13); !errors.As(err, &notFound) {
+		t.Errorf("expected NotFoundError, got %v", err)
+	}
+
+	srv.Fail("", http.StatusUnauthorized)
+	var authErr *bgg.AuthError
+	if _, err := client.GetHotGames(); !errors.As(err, &authErr) {
+		t.Errorf("expected AuthError, got %v", err)
+	}
+
+	srv.Reset()
+	srv.FailN("/hot", http.StatusServiceUnavailable, 2)
+	if _, err := client.GetHotGames(); err != nil {
+		t.Errorf("expected 503s to be retried, got %v", err)
+	}
+
+	srv.FailN("/hot", http.StatusTooManyRequests, 1)
+	if _, err := client.GetHotGames(); err != nil {
+		t.Errorf("expected 429 to be retried, got %v", err)
+	}
+}
+
+func TestServer_Latency(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := newTestClient(t, srv)
+
+	srv.SetLatency(50 * time.Millisecond)
+	start := time.Now()
+	if _, err := client.GetHotGames(); err != nil {
+		t.Fatalf("GetHotGames() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected latency to be applied, took %v", elapsed)
+	}
+}
+
+func TestServer_SetResponse(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := newTestClient(t, srv)
+
+	srv.SetResponse("/hot", []byte(`<items><item id="1" rank="1"><name value="Custom"/></item></items>`))
+	hot, err := client.GetHotGames()
+	if err != nil || len(hot) != 1 || hot[0].Name != "Custom" {
+		t.Errorf("GetHotGames() = %+v, %v", hot, err)
+	}
+}
@@ -0,0 +1,278 @@
+package bgg
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default cache TTLs used when the corresponding CacheTTLs field is zero.
+const (
+	DefaultHotCacheTTL        = 15 * time.Minute
+	DefaultThingCacheTTL      = 6 * time.Hour
+	DefaultCollectionCacheTTL = 30 * time.Minute
+	DefaultCacheTTL           = 10 * time.Minute
+)
+
+// Cache stores raw API response bodies keyed by request URL.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the cached body for key, if present and not expired.
+	Get(key string) ([]byte, bool)
+	// Set stores body under key for the given duration.
+	Set(key string, body []byte, ttl time.Duration)
+}
+
+// CacheTTLs configures how long responses are cached per endpoint.
+// A zero value uses the default; a negative value disables caching
+// for that endpoint.
+type CacheTTLs struct {
+	Hot        time.Duration // /hot (default: 15m)
+	Thing      time.Duration // /thing and /family (default: 6h)
+	Collection time.Duration // /collection (default: 30m)
+	Default    time.Duration // all other endpoints (default: 10m)
+}
+
+// ttlFor returns the TTL for the given endpoint.
+func (t CacheTTLs) ttlFor(endpoint string) time.Duration {
+	path := endpoint
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+
+	ttl, def := t.Default, DefaultCacheTTL
+	switch path {
+	case "/hot":
+		ttl, def = t.Hot, DefaultHotCacheTTL
+	case "/thing", "/family":
+		ttl, def = t.Thing, DefaultThingCacheTTL
+	case "/collection":
+		ttl, def = t.Collection, DefaultCollectionCacheTTL
+	}
+	if ttl == 0 {
+		return def
+	}
+	return ttl
+}
+
+// CacheStatus reports whether a response was served from the cache.
+type CacheStatus int
+
+const (
+	// CacheDisabled means no cache was consulted.
+	CacheDisabled CacheStatus = iota
+	// CacheMiss means the response was fetched from BGG.
+	CacheMiss
+	// CacheHit means the response was served from the cache.
+	CacheHit
+)
+
+// String returns the string representation of a CacheStatus.
+func (s CacheStatus) String() string {
+	switch s {
+	case CacheMiss:
+		return "miss"
+	case CacheHit:
+		return "hit"
+	default:
+		return "disabled"
+	}
+}
+
+type cacheStatusKey struct{}
+type noCacheKey struct{}
+
+// cacheStatusRecorder guards a caller's CacheStatus, as methods such as
+// GetGamesAll record into it from several goroutines.
+type cacheStatusRecorder struct {
+	mu     sync.Mutex
+	status *CacheStatus
+}
+
+// WithCacheStatus returns a context that records into status whether the
+// request made with it was served from the cache. If a method makes several
+// requests, any miss is reported as CacheMiss. Read status only after the
+// method has returned.
+func WithCacheStatus(ctx context.Context, status *CacheStatus) context.Context {
+	return context.WithValue(ctx, cacheStatusKey{}, &cacheStatusRecorder{status: status})
+}
+
+// WithNoCache returns a context whose requests bypass the cache lookup.
+// Fresh responses are still stored, so this can be used to force a refresh.
+func WithNoCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+// recordCacheStatus stores status into the context's CacheStatus, if any.
+func recordCacheStatus(ctx context.Context, status CacheStatus) {
+	r, ok := ctx.Value(cacheStatusKey{}).(*cacheStatusRecorder)
+	if !ok || r.status == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if *r.status == CacheMiss && status == CacheHit {
+		return
+	}
+	*r.status = status
+}
+
+// MemoryCache is an in-memory Cache.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+type memoryCacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+// NewMemoryCache creates an empty in-memory cache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]memoryCacheEntry)}
+}
+
+// Get returns the cached body for key, if present and not expired.
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(e.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return e.body, true
+}
+
+// Set stores body under key for the given duration. Expired entries are
+// dropped at the same time, so keys that are never read again do not pile up.
+func (c *MemoryCache) Set(key string, body []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = memoryCacheEntry{body: body, expires: now.Add(ttl)}
+}
+
+// FileCache is a Cache that stores each response as a file in a directory,
+// so cached data survives restarts.
+type FileCache struct {
+	dir string
+}
+
+// fileCacheStaleTemp is the age after which a leftover temporary file from
+// an interrupted Set is removed.
+const fileCacheStaleTemp = time.Hour
+
+// NewFileCache creates a file cache in dir, creating the directory if needed.
+// Expired entries left by earlier runs are removed.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	c := &FileCache{dir: dir}
+	c.prune()
+	return c, nil
+}
+
+// prune removes expired entries and stale temporary files. Errors are
+// ignored; whatever is left is pruned on the next run.
+func (c *FileCache) prune() {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	for _, entry := range entries {
+		name := filepath.Join(c.dir, entry.Name())
+		switch {
+		case strings.HasPrefix(entry.Name(), "tmp-"):
+			if info, err := entry.Info(); err == nil && now.Sub(info.ModTime()) > fileCacheStaleTemp {
+				os.Remove(name)
+			}
+		case strings.HasSuffix(entry.Name(), ".xml"):
+			if expires, ok := readFileCacheExpiry(name); ok && now.After(expires) {
+				os.Remove(name)
+			}
+		}
+	}
+}
+
+// readFileCacheExpiry reads the expiry header of a cache file.
+func readFileCacheExpiry(name string) (time.Time, bool) {
+	f, err := os.Open(name)
+	if err != nil {
+		return time.Time{}, false
+	}
+	defer f.Close()
+
+	var header [8]byte
+	if _, err := io.ReadFull(f, header[:]); err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, int64(binary.BigEndian.Uint64(header[:]))), true
+}
+
+// path returns the file path for key.
+func (c *FileCache) path(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(h[:16])+".xml")
+}
+
+// Get returns the cached body for key, if present and not expired.
+// Each file starts with its expiry time as 8 bytes of Unix nanoseconds.
+func (c *FileCache) Get(key string) ([]byte, bool) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil || len(data) < 8 {
+		return nil, false
+	}
+	expires := time.Unix(0, int64(binary.BigEndian.Uint64(data[:8])))
+	if time.Now().After(expires) {
+		os.Remove(c.path(key))
+		return nil, false
+	}
+	return data[8:], true
+}
+
+// Set stores body under key for the given duration. Write errors are
+// ignored; the response is simply fetched again next time.
+func (c *FileCache) Set(key string, body []byte, ttl time.Duration) {
+	data := make([]byte, 8+len(body))
+	binary.BigEndian.PutUint64(data[:8], uint64(time.Now().Add(ttl).UnixNano()))
+	copy(data[8:], body)
+
+	// Write to a temporary file first so readers never see a partial entry
+	tmp, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		return
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
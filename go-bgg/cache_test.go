@@ -0,0 +1,230 @@
+package bgg
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache(t *testing.T) {
+	c := NewMemoryCache()
+
+	if _, ok := c.Get("missing"); ok {
+		t.Error("expected miss for unknown key")
+	}
+
+	c.Set("key", []byte("<ok/>"), time.Minute)
+	body, ok := c.Get("key")
+	if !ok || string(body) != "<ok/>" {
+		t.Errorf("Get() = %q, %v, want %q, true", body, ok, "<ok/>")
+	}
+
+	c.Set("expired", []byte("<old/>"), -time.Second)
+	if _, ok := c.Get("expired"); ok {
+		t.Error("expected miss for expired entry")
+	}
+}
+
+func TestMemoryCache_PrunesOnSet(t *testing.T) {
+	c := NewMemoryCache()
+
+	c.Set("expired", []byte("<old/>"), -time.Second)
+	c.Set("key", []byte("<ok/>"), time.Minute)
+
+	// "expired" is never read again but must not stay in memory
+	if _, ok := c.entries["expired"]; ok {
+		t.Error("expected expired entry to be pruned on Set")
+	}
+	if len(c.entries) != 1 {
+		t.Errorf("expected 1 entry, got %d", len(c.entries))
+	}
+}
+
+func TestFileCache(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewFileCache(dir)
+	if err != nil {
+		t.Fatalf("NewFileCache() error = %v", err)
+	}
+
+	c.Set("https://example.com/thing?id=13", []byte("<items/>"), time.Minute)
+	body, ok := c.Get("https://example.com/thing?id=13")
+	if !ok || string(body) != "<items/>" {
+		t.Errorf("Get() = %q, %v, want %q, true", body, ok, "<items/>")
+	}
+
+	// A second cache on the same directory sees the entry
+	c2, _ := NewFileCache(dir)
+	if _, ok := c2.Get("https://example.com/thing?id=13"); !ok {
+		t.Error("expected entry to persist across cache instances")
+	}
+
+	c.Set("expired", []byte("<old/>"), -time.Second)
+	if _, ok := c.Get("expired"); ok {
+		t.Error("expected miss for expired entry")
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("expected expired entry to be removed, got %d files", len(entries))
+	}
+}
+
+func TestFileCache_PrunesOnOpen(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewFileCache(dir)
+	if err != nil {
+		t.Fatalf("NewFileCache() error = %v", err)
+	}
+
+	c.Set("fresh", []byte("<new/>"), time.Minute)
+	c.Set("expired", []byte("<old/>"), -time.Second)
+
+	// Leftovers of an interrupted Set: an old and a recent temporary file
+	old := filepath.Join(dir, "tmp-old")
+	recent := filepath.Join(dir, "tmp-recent")
+	for _, name := range []string{old, recent} {
+		if err := os.WriteFile(name, []byte("partial"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	past := time.Now().Add(-2 * fileCacheStaleTemp)
+	if err := os.Chtimes(old, past, past); err != nil {
+		t.Fatal(err)
+	}
+
+	// "expired" is never read again; reopening the cache removes it
+	if _, err := NewFileCache(dir); err != nil {
+		t.Fatalf("NewFileCache() error = %v", err)
+	}
+
+	if _, err := os.Stat(c.path("expired")); !os.IsNotExist(err) {
+		t.Error("expected expired entry to be removed")
+	}
+	if _, err := os.Stat(old); !os.IsNotExist(err) {
+		t.Error("expected stale temporary file to be removed")
+	}
+	if _, err := os.Stat(recent); err != nil {
+		t.Errorf("expected recent temporary file to be kept: %v", err)
+	}
+	if _, ok := c.Get("fresh"); !ok {
+		t.Error("expected fresh entry to be kept")
+	}
+}
+
+func TestCacheTTLs_TTLFor(t *testing.T) {
+	custom := CacheTTLs{Collection: 5 * time.Minute, Hot: -1}
+
+	tests := []struct {
+		name     string
+		ttls     CacheTTLs
+		endpoint string
+		want     time.Duration
+	}{
+		{"hot default", CacheTTLs{}, "/hot?type=boardgame", DefaultHotCacheTTL},
+		{"thing default", CacheTTLs{}, "/thing?id=13&stats=1", DefaultThingCacheTTL},
+		{"family default", CacheTTLs{}, "/family?id=3", DefaultThingCacheTTL},
+		{"collection default", CacheTTLs{}, "/collection?username=a", DefaultCollectionCacheTTL},
+		{"other default", CacheTTLs{}, "/search?query=catan", DefaultCacheTTL},
+		{"collection custom", custom, "/collection?username=a", 5 * time.Minute},
+		{"hot disabled", custom, "/hot?type=boardgame", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ttls.ttlFor(tt.endpoint); got != tt.want {
+				t.Errorf("ttlFor(%q) = %v, want %v", tt.endpoint, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Cache(t *testing.T) {
+	testData, err := os.ReadFile("testdata/hot_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+	client.cache = NewMemoryCache()
+
+	var status CacheStatus
+	if _, err := client.GetHotGamesContext(WithCacheStatus(context.Background(), &status)); err != nil {
+		t.Fatalf("GetHotGames failed: %v", err)
+	}
+	if status != CacheMiss {
+		t.Errorf("first request status = %v, want miss", status)
+	}
+
+	status = CacheDisabled
+	games, err := client.GetHotGamesContext(WithCacheStatus(context.Background(), &status))
+	if err != nil {
+		t.Fatalf("GetHotGames failed: %v", err)
+	}
+	if status != CacheHit {
+		t.Errorf("second request status = %v, want hit", status)
+	}
+	if len(games) == 0 {
+		t.Error("expected games from cached response")
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("expected 1 request, got %d", n)
+	}
+
+	// WithNoCache bypasses the lookup
+	if _, err := client.GetHotGamesContext(WithNoCache(context.Background())); err != nil {
+		t.Fatalf("GetHotGames failed: %v", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Errorf("expected 2 requests after WithNoCache, got %d", n)
+	}
+}
+
+func TestClient_CacheSkipsErrorsAndDisabledEndpoints(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Path == "/thing" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<items></items>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+	client.cache = NewMemoryCache()
+	client.cacheTTLs = CacheTTLs{Hot: -1}
+
+	for i := 0; i < 2; i++ {
+		client.GetGame(13)
+	}
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Errorf("expected error responses not to be cached, got %d requests", n)
+	}
+
+	var status CacheStatus
+	for i := 0; i < 2; i++ {
+		client.GetHotGamesContext(WithCacheStatus(context.Background(), &status))
+	}
+	if n := atomic.LoadInt32(&requests); n != 4 {
+		t.Errorf("expected disabled endpoint not to be cached, got %d requests", n)
+	}
+	if status != CacheDisabled {
+		t.Errorf("status = %v, want disabled", status)
+	}
+}
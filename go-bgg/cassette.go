@@ -0,0 +1,115 @@
+package bgg
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// maxCassetteName keeps cassette names within common file name limits.
+const maxCassetteName = 200
+
+// CassetteName returns the file name under which a response for u is
+// recorded, derived from the URL path and sorted query, e.g.
+// "xmlapi2_thing_id=13&stats=1.xml". Names that would be too long, e.g. for
+// long non-ASCII search queries, are shortened and end in a hash of the
+// full name instead.
+func CassetteName(u *url.URL) string {
+	name := strings.ReplaceAll(strings.Trim(u.Path, "/"), "/", "_")
+	if q := u.Query().Encode(); q != "" {
+		name += "_" + q
+	}
+	if len(name)+len(".xml") > maxCassetteName {
+		h := sha256.Sum256([]byte(name))
+		sum := hex.EncodeToString(h[:16])
+		name = name[:maxCassetteName-len(".xml")-len(sum)-1] + "_" + sum
+	}
+	return name + ".xml"
+}
+
+// recordingTransport forwards requests and saves successful response bodies.
+type recordingTransport struct {
+	dir     string
+	next    http.RoundTripper
+	onError func(error)
+}
+
+// NewRecordingTransport returns an http.RoundTripper that forwards requests
+// to next (http.DefaultTransport if nil) and writes every 200 response body
+// to dir, named by CassetteName. Use it as the transport of Config.HTTPClient
+// to capture real BGG traffic as fixtures.
+//
+// A response that cannot be written is still returned; the error is passed
+// to onError if it is not nil.
+func NewRecordingTransport(dir string, next http.RoundTripper, onError func(error)) (http.RoundTripper, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &recordingTransport{dir: dir, next: next, onError: onError}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	// A failed recording must not fail the live request
+	if err := os.WriteFile(filepath.Join(t.dir, CassetteName(req.URL)), body, 0644); err != nil && t.onError != nil {
+		t.onError(fmt.Errorf("failed to record response: %w", err))
+	}
+	return resp, nil
+}
+
+// replayTransport serves responses recorded by a recordingTransport.
+type replayTransport struct {
+	dir string
+}
+
+// NewReplayTransport returns an http.RoundTripper that serves responses
+// recorded in dir by NewRecordingTransport without touching the network.
+// Requests without a recording get a 404 response.
+func NewReplayTransport(dir string) http.RoundTripper {
+	return &replayTransport{dir: dir}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	name := CassetteName(req.URL)
+	status := http.StatusOK
+	body, err := os.ReadFile(filepath.Join(t.dir, name))
+	if err != nil {
+		status = http.StatusNotFound
+		body = []byte("no recording for " + name)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"text/xml; charset=utf-8"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,149 @@
+package bgg
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCassetteName(t *testing.T) {
+	tests := []struct {
+		rawURL string
+		want   string
+	}{
+		{"https://boardgamegeek.com/xmlapi2/thing?stats=1&id=13", "xmlapi2_thing_id=13&stats=1.xml"},
+		{"https://boardgamegeek.com/xmlapi2/hot?type=boardgame", "xmlapi2_hot_type=boardgame.xml"},
+		{"https://boardgamegeek.com/xmlapi/geeklist/123", "xmlapi_geeklist_123.xml"},
+		{"http://127.0.0.1:8080/collection?username=a&modifiedsince=2024-01-01+10:00:00", "collection_modifiedsince=2024-01-01+10%3A00%3A00&username=a.xml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rawURL, func(t *testing.T) {
+			u, err := url.Parse(tt.rawURL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := CassetteName(u); got != tt.want {
+				t.Errorf("CassetteName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCassetteName_Long(t *testing.T) {
+	long := func(q string) string {
+		u, _ := url.Parse("https://boardgamegeek.com/xmlapi2/search?query=" + url.QueryEscape(q))
+		return CassetteName(u)
+	}
+
+	// Non-ASCII queries grow ninefold once escaped
+	a := long(strings.Repeat("カタン", 20) + "1")
+	b := long(strings.Repeat("カタン", 20) + "2")
+	if len(a) > maxCassetteName || !strings.HasPrefix(a, "xmlapi2_search_query=") || !strings.HasSuffix(a, ".xml") {
+		t.Errorf("unexpected long cassette name %q (%d bytes)", a, len(a))
+	}
+	if a == b {
+		t.Error("expected different queries to get different cassette names")
+	}
+	if a != long(strings.Repeat("カタン", 20)+"1") {
+		t.Error("expected cassette names to be stable")
+	}
+}
+
+func TestRecordingTransport_WriteError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<items><item id="13" rank="1"><name value="CATAN"/></item></items>`))
+	}))
+	defer server.Close()
+
+	var recordErr error
+	dir := t.TempDir()
+	recorder, err := NewRecordingTransport(dir, server.Client().Transport, func(err error) { recordErr = err })
+	if err != nil {
+		t.Fatalf("NewRecordingTransport() error = %v", err)
+	}
+	// Writes into a directory that has gone away fail
+	if err := os.Remove(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	client, _ := NewClient(Config{
+		Token:      "test-token",
+		BaseURL:    server.URL,
+		HTTPClient: &http.Client{Transport: recorder},
+	})
+	games, err := client.GetHotGames()
+	if err != nil {
+		t.Fatalf("expected the live request to succeed, got %v", err)
+	}
+	if len(games) != 1 {
+		t.Errorf("expected 1 game, got %d", len(games))
+	}
+	if recordErr == nil {
+		t.Error("expected the write error to be reported")
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	testData, err := os.ReadFile("testdata/thing_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	recorder, err := NewRecordingTransport(dir, server.Client().Transport, nil)
+	if err != nil {
+		t.Fatalf("NewRecordingTransport() error = %v", err)
+	}
+
+	client, _ := NewClient(Config{
+		Token:      "test-token",
+		BaseURL:    server.URL,
+		HTTPClient: &http.Client{Transport: recorder},
+	})
+	recorded, err := client.GetGame(13)
+	if err != nil {
+		t.Fatalf("GetGame() while recording error = %v", err)
+	}
+
+	saved, err := os.ReadFile(filepath.Join(dir, "thing_id=13&stats=1.xml"))
+	if err != nil {
+		t.Fatalf("expected recorded fixture: %v", err)
+	}
+	if string(saved) != string(testData) {
+		t.Error("recorded fixture does not match the response")
+	}
+
+	// Replay without the server
+	server.Close()
+	client, _ = NewClient(Config{
+		Token:      "test-token",
+		BaseURL:    server.URL,
+		HTTPClient: &http.Client{Transport: NewReplayTransport(dir)},
+	})
+	replayed, err := client.GetGame(13)
+	if err != nil {
+		t.Fatalf("GetGame() while replaying error = %v", err)
+	}
+	if replayed.Name != recorded.Name || replayed.Rating != recorded.Rating {
+		t.Errorf("replayed game %q differs from recorded %q", replayed.Name, recorded.Name)
+	}
+
+	_, err = client.GetGame(42)
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected NotFoundError for a missing recording, got %v", err)
+	}
+}
@@ -1,9 +1,11 @@
 package bgg
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -11,6 +13,10 @@ const (
 	// BaseURL is the base URL for the BGG XML API.
 	BaseURL = "https://boardgamegeek.com/xmlapi2"
 
+	// LegacyBaseURL is the base URL for the BGG XML API v1.
+	// Some resources (e.g. GeekLists) are only available there.
+	LegacyBaseURL = "https://boardgamegeek.com/xmlapi"
+
 	// DefaultTimeout is the default HTTP request timeout.
 	DefaultTimeout = 30 * time.Second
 
@@ -19,6 +25,15 @@ const (
 
 	// DefaultRetryDelay is the default delay between retries.
 	DefaultRetryDelay = 2 * time.Second
+
+	// DefaultRetryJitter is the fraction of each retry delay that is randomized.
+	DefaultRetryJitter = 0.2
+
+	// DefaultRateLimit is the default number of requests per second.
+	DefaultRateLimit = 2.0
+
+	// DefaultRateBurst is the default number of requests allowed at once.
+	DefaultRateBurst = 5
 )
 
 // Config holds the configuration for the BGG API client.
@@ -27,6 +42,18 @@ type Config struct {
 	Timeout    time.Duration // Optional: HTTP request timeout (default: 30s)
 	RetryCount int           // Optional: Number of retry attempts (default: 3)
 	RetryDelay time.Duration // Optional: Delay between retries (default: 2s)
+	Cache      Cache         // Optional: Response cache (default: none)
+	CacheTTLs  CacheTTLs     // Optional: Per-endpoint cache TTLs
+	RateLimit  float64       // Optional: Requests per second (default: 2, negative disables)
+	RateBurst  int           // Optional: Max requests at once (default: 5)
+
+	HTTPClient *http.Client // Optional: HTTP client to use, e.g. with a proxy or custom transport (Timeout is then ignored)
+	BaseURL    string       // Optional: XML API v2 base URL, e.g. a mirror or mock server (default: BaseURL)
+	UserAgent  string       // Optional: User-Agent header sent with each request
+	Hooks      Hooks        // Optional: Observer for requests, responses, retries and errors
+
+	RetryPolicy   RetryPolicy   // Optional: Retry policy for all endpoints (default: exponential backoff with jitter from RetryCount and RetryDelay)
+	RetryPolicies RetryPolicies // Optional: Per-endpoint retry policy overrides
 }
 
 // Client is the BGG API client.
@@ -36,6 +63,21 @@ type Client struct {
 	retryCount int
 	retryDelay time.Duration
 	baseURL    string
+	// legacyBaseURL is used for resources only served by the XML API v1
+	legacyBaseURL string
+	userAgent     string
+
+	cache     Cache
+	cacheTTLs CacheTTLs
+	// limiter is shared by all requests; nil disables rate limiting
+	limiter *rateLimiter
+	hooks   Hooks
+	// flights coalesces identical concurrent requests
+	flights flightGroup
+
+	// retryPolicy is Config.RetryPolicy; nil uses retryCount and retryDelay
+	retryPolicy   RetryPolicy
+	retryPolicies RetryPolicies
 }
 
 // NewClient creates a new BGG API client.
@@ -59,77 +101,226 @@ func NewClient(cfg Config) (*Client, error) {
 		retryDelay = DefaultRetryDelay
 	}
 
-	return &Client{
-		httpClient: &http.Client{
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{
 			Timeout: timeout,
-		},
-		token:      cfg.Token,
-		retryCount: retryCount,
-		retryDelay: retryDelay,
-		baseURL:    BaseURL,
+		}
+	}
+
+	baseURL, legacyBaseURL := BaseURL, LegacyBaseURL
+	if cfg.BaseURL != "" {
+		baseURL, legacyBaseURL = resolveBaseURLs(cfg.BaseURL)
+	}
+
+	var limiter *rateLimiter
+	if cfg.RateLimit >= 0 {
+		rate := cfg.RateLimit
+		if rate == 0 {
+			rate = DefaultRateLimit
+		}
+		burst := cfg.RateBurst
+		if burst == 0 {
+			burst = DefaultRateBurst
+		}
+		limiter = newRateLimiter(rate, burst)
+	}
+
+	return &Client{
+		httpClient:    httpClient,
+		token:         cfg.Token,
+		retryCount:    retryCount,
+		retryDelay:    retryDelay,
+		baseURL:       baseURL,
+		legacyBaseURL: legacyBaseURL,
+		userAgent:     cfg.UserAgent,
+		cache:         cfg.Cache,
+		cacheTTLs:     cfg.CacheTTLs,
+		limiter:       limiter,
+		hooks:         cfg.Hooks,
+		retryPolicy:   cfg.RetryPolicy,
+		retryPolicies: cfg.RetryPolicies,
 	}, nil
 }
 
-// parseRetryAfter extracts the Retry-After duration from an HTTP response header.
-func parseRetryAfter(header http.Header, defaultDelay time.Duration) time.Duration {
-	if ra := header.Get("Retry-After"); ra != "" {
-		if d, err := time.ParseDuration(ra + "s"); err == nil {
-			return d
-		}
+// resolveBaseURLs returns the XML API v2 and v1 base URLs for a custom v2
+// base URL. A URL ending in "/xmlapi2" maps to the sibling "/xmlapi";
+// anything else (e.g. a mock server) is assumed to serve both APIs.
+func resolveBaseURLs(baseURL string) (string, string) {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	if strings.HasSuffix(baseURL, "/xmlapi2") {
+		return baseURL, strings.TrimSuffix(baseURL, "2")
+	}
+	return baseURL, baseURL
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
-	return defaultDelay
 }
 
 // requestOptions controls retry behavior for HTTP requests.
 type requestOptions struct {
-	maxRetries         int
-	exponentialBackoff bool // true: delay*attempt, false: fixed delay
-	retryOn429         bool // retry on 429 with sleep
-	retryOn503         bool // retry on 503
+	policy     RetryPolicy
+	retryOn429 bool // retry on 429 with sleep
+	retryOn503 bool // retry on 503
+	legacy     bool // use the XML API v1 base URL
+
+	// onRetry is called before waiting delay for retry n
+	onRetry func(n int, delay time.Duration, err error)
 }
 
 // doRequestWithOpts performs an HTTP GET request with configurable retry behavior.
-func (c *Client) doRequestWithOpts(endpoint string, opts requestOptions) ([]byte, error) {
+// Identical concurrent requests share a single HTTP call. Cancelling ctx
+// returns immediately and, once no other caller waits for the call, aborts
+// the in-flight request as well as any backoff or Retry-After wait.
+// Successful responses are cached if a Cache is configured.
+func (c *Client) doRequestWithOpts(ctx context.Context, endpoint string, opts requestOptions) ([]byte, error) {
 	url := c.baseURL + endpoint
+	if opts.legacy {
+		url = c.legacyBaseURL + endpoint
+	}
+
+	var ttl time.Duration
+	if c.cache != nil {
+		ttl = c.cacheTTLs.ttlFor(endpoint)
+	}
+	if ttl > 0 {
+		if noCache, _ := ctx.Value(noCacheKey{}).(bool); !noCache {
+			if body, ok := c.cache.Get(url); ok {
+				recordCacheStatus(ctx, CacheHit)
+				return body, nil
+			}
+		}
+		recordCacheStatus(ctx, CacheMiss)
+	}
+
+	if opts.onRetry != nil {
+		// Retry reports belong to this caller alone, so don't share the call
+		return c.fetch(ctx, endpoint, url, ttl, opts)
+	}
+	return c.flights.do(ctx, url, func(ctx context.Context) ([]byte, error) {
+		return c.fetch(ctx, endpoint, url, ttl, opts)
+	})
+}
+
+// fetch performs the HTTP request for doRequestWithOpts, retrying per
+// opts.policy and caching a successful response for ttl.
+func (c *Client) fetch(ctx context.Context, endpoint, url string, ttl time.Duration, opts requestOptions) (result []byte, resultErr error) {
+	ev := RequestEvent{Endpoint: endpoint, URL: url}
+	if c.hooks != nil {
+		defer func() {
+			if resultErr != nil {
+				ev.Err = resultErr
+				c.hooks.OnError(ctx, ev)
+			}
+		}()
+	}
 
 	var lastErr error
-	for attempt := 0; attempt <= opts.maxRetries; attempt++ {
+	var retryAfter time.Duration
+	for attempt := 0; ; attempt++ {
 		if attempt > 0 {
-			if opts.exponentialBackoff {
-				time.Sleep(c.retryDelay * time.Duration(attempt))
-			} else {
-				time.Sleep(c.retryDelay)
+			delay, ok := opts.policy.Backoff(attempt, lastErr)
+			if !ok {
+				break
+			}
+			if c.limiter == nil {
+				// Without a limiter, honor Retry-After here
+				delay += retryAfter
+			}
+			retryAfter = 0
+
+			if c.hooks != nil {
+				ev.Err = lastErr
+				ev.Delay = delay
+				c.hooks.OnRetry(ctx, ev)
+			}
+			if opts.onRetry != nil {
+				opts.onRetry(attempt, delay, lastErr)
+			}
+			if err := sleepContext(ctx, delay); err != nil {
+				return nil, newNetworkError("request canceled", 0, err)
+			}
+		}
+
+		if c.limiter != nil {
+			if err := c.limiter.wait(ctx); err != nil {
+				return nil, newNetworkError("request canceled", 0, err)
 			}
 		}
 
-		req, err := http.NewRequest(http.MethodGet, url, nil)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 		if err != nil {
 			return nil, newNetworkError("failed to create request", 0, err)
 		}
 
 		req.Header.Set("Authorization", "Bearer "+c.token)
 		req.Header.Set("Accept", "application/xml")
+		if c.userAgent != "" {
+			req.Header.Set("User-Agent", c.userAgent)
+		}
+
+		ev = RequestEvent{Endpoint: endpoint, URL: url, Attempt: attempt + 1}
+		if c.hooks != nil {
+			c.hooks.OnRequest(ctx, ev)
+		}
+		start := time.Now()
 
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
+			ev.Latency = time.Since(start)
+			if ctx.Err() != nil {
+				return nil, newNetworkError("request canceled", 0, ctx.Err())
+			}
 			lastErr = newNetworkError("request failed", 0, err)
 			continue
 		}
 
 		body, err := io.ReadAll(resp.Body)
 		resp.Body.Close()
+		ev.StatusCode = resp.StatusCode
+		ev.Latency = time.Since(start)
+		ev.Bytes = len(body)
 		if err != nil {
 			lastErr = newNetworkError("failed to read response body", resp.StatusCode, err)
 			continue
 		}
+		if c.hooks != nil {
+			c.hooks.OnResponse(ctx, ev)
+		}
 
 		switch resp.StatusCode {
 		case http.StatusOK:
+			if err := parseErrorDocument(body); err != nil {
+				if _, ok := err.(*ProcessingError); ok {
+					// Queued like a 202, just with a 200 status
+					lastErr = err
+					continue
+				}
+				return nil, err
+			}
+			if ttl > 0 {
+				c.cache.Set(url, body, ttl)
+			}
 			return body, nil
 
 		case http.StatusAccepted:
 			// 202 Accepted - BGG is processing the request, retry needed
-			lastErr = newNetworkError("request accepted but not ready, retry needed", resp.StatusCode, nil)
+			lastErr = newProcessingError("")
+			if err, ok := parseErrorDocument(body).(*ProcessingError); ok {
+				lastErr = err
+			}
 			continue
 
 		case http.StatusUnauthorized:
@@ -139,12 +330,15 @@ func (c *Client) doRequestWithOpts(endpoint string, opts requestOptions) ([]byte
 			return nil, newNotFoundError(0)
 
 		case http.StatusTooManyRequests:
-			retryAfter := parseRetryAfter(resp.Header, 5*time.Second)
+			retryAfter = parseRetryAfter(resp.Header, 5*time.Second)
+			if c.limiter != nil {
+				// Hold back every request of this client, not just this one
+				c.limiter.pause(retryAfter)
+			}
 			if !opts.retryOn429 {
 				return nil, newRateLimitError("rate limit exceeded", retryAfter)
 			}
 			lastErr = newRateLimitError("rate limit exceeded", retryAfter)
-			time.Sleep(retryAfter)
 			continue
 
 		case http.StatusServiceUnavailable:
@@ -166,22 +360,63 @@ func (c *Client) doRequestWithOpts(endpoint string, opts requestOptions) ([]byte
 }
 
 // doRequest performs an HTTP GET request with authentication and retry logic.
-func (c *Client) doRequest(endpoint string) ([]byte, error) {
-	return c.doRequestWithOpts(endpoint, requestOptions{
-		maxRetries:         c.retryCount,
-		exponentialBackoff: true,
-		retryOn429:         true,
-		retryOn503:         true,
+func (c *Client) doRequest(ctx context.Context, endpoint string) ([]byte, error) {
+	return c.doRequestWithOpts(ctx, endpoint, requestOptions{
+		policy:     c.retryPolicyFor(endpoint, c.defaultRetryPolicy()),
+		retryOn429: true,
+		retryOn503: true,
+	})
+}
+
+// doLegacyRequest performs a request against the XML API v1 with the same
+// retry logic as doRequest.
+func (c *Client) doLegacyRequest(ctx context.Context, endpoint string) ([]byte, error) {
+	return c.doRequestWithOpts(ctx, endpoint, requestOptions{
+		policy:     c.retryPolicyFor(endpoint, c.defaultRetryPolicy()),
+		retryOn429: true,
+		retryOn503: true,
+		legacy:     true,
 	})
 }
 
 // doRequestWithRetryOn202 performs a request with special handling for 202 responses.
 // This is used for Collection API which returns 202 when data is being prepared.
-func (c *Client) doRequestWithRetryOn202(endpoint string, maxRetries int) ([]byte, error) {
-	return c.doRequestWithOpts(endpoint, requestOptions{
-		maxRetries:         maxRetries,
-		exponentialBackoff: false,
-		retryOn429:         false,
-		retryOn503:         false,
-	})
+// Unless a policy is configured, it retries maxRetries times with a fixed delay.
+func (c *Client) doRequestWithRetryOn202(ctx context.Context, endpoint string, maxRetries int) ([]byte, error) {
+	return c.doRequestWithOpts(ctx, endpoint, c.retryOn202Options(endpoint, maxRetries))
+}
+
+// retryOn202Options returns the request options used by doRequestWithRetryOn202.
+func (c *Client) retryOn202Options(endpoint string, maxRetries int) requestOptions {
+	return requestOptions{
+		policy:     c.retryPolicyFor(endpoint, &FixedBackoff{Delay: c.retryDelay, MaxRetries: maxRetries}),
+		retryOn429: false,
+		retryOn503: false,
+	}
+}
+
+// retryPolicyFor returns the policy for endpoint: its override from
+// RetryPolicies, else Config.RetryPolicy, else fallback.
+func (c *Client) retryPolicyFor(endpoint string, fallback RetryPolicy) RetryPolicy {
+	if p := c.retryPolicies.policyFor(endpoint); p != nil {
+		return p
+	}
+	if c.retryPolicy != nil {
+		return c.retryPolicy
+	}
+	return fallback
+}
+
+// defaultRetryPolicy returns the policy built from RetryCount and RetryDelay.
+func (c *Client) defaultRetryPolicy() RetryPolicy {
+	maxRetries := c.retryCount
+	if maxRetries == 0 {
+		// NewClient already applied the default; zero means no retries here
+		maxRetries = -1
+	}
+	return &ExponentialBackoff{
+		Base:       c.retryDelay,
+		MaxRetries: maxRetries,
+		Jitter:     DefaultRetryJitter,
+	}
 }
@@ -1,6 +1,7 @@
 package bgg
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -60,6 +61,59 @@ func TestNewClient(t *testing.T) {
 	}
 }
 
+func TestNewClient_CustomTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ua := r.Header.Get("User-Agent"); ua != "bgg-tui/1.0" {
+			t.Errorf("expected User-Agent 'bgg-tui/1.0', got %q", ua)
+		}
+		if r.URL.Path != "/hot" {
+			t.Errorf("expected path '/hot', got '%s'", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("<items></items>"))
+	}))
+	defer server.Close()
+
+	httpClient := server.Client()
+	client, err := NewClient(Config{
+		Token:      "test-token",
+		HTTPClient: httpClient,
+		BaseURL:    server.URL + "/",
+		UserAgent:  "bgg-tui/1.0",
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if client.httpClient != httpClient {
+		t.Error("expected the configured HTTP client to be used")
+	}
+
+	if _, err := client.GetHotGames(); err != nil {
+		t.Fatalf("GetHotGames() error = %v", err)
+	}
+}
+
+func TestResolveBaseURLs(t *testing.T) {
+	tests := []struct {
+		baseURL    string
+		wantBase   string
+		wantLegacy string
+	}{
+		{"https://mirror.example.com/xmlapi2", "https://mirror.example.com/xmlapi2", "https://mirror.example.com/xmlapi"},
+		{"https://mirror.example.com/xmlapi2/", "https://mirror.example.com/xmlapi2", "https://mirror.example.com/xmlapi"},
+		{"http://localhost:8080", "http://localhost:8080", "http://localhost:8080"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.baseURL, func(t *testing.T) {
+			base, legacy := resolveBaseURLs(tt.baseURL)
+			if base != tt.wantBase || legacy != tt.wantLegacy {
+				t.Errorf("resolveBaseURLs(%q) = %q, %q, want %q, %q", tt.baseURL, base, legacy, tt.wantBase, tt.wantLegacy)
+			}
+		})
+	}
+}
+
 func TestDoRequest_Success(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		auth := r.Header.Get("Authorization")
@@ -79,7 +133,7 @@ func TestDoRequest_Success(t *testing.T) {
 		baseURL:    server.URL,
 	}
 
-	body, err := client.doRequest("/hot")
+	body, err := client.doRequest(context.Background(), "/hot")
 	if err != nil {
 		t.Fatalf("doRequest() error = %v", err)
 	}
@@ -102,7 +156,7 @@ func TestDoRequest_AuthError(t *testing.T) {
 		baseURL:    server.URL,
 	}
 
-	_, err := client.doRequest("/hot")
+	_, err := client.doRequest(context.Background(), "/hot")
 	if err == nil {
 		t.Fatal("expected error for 401 response")
 	}
@@ -127,7 +181,7 @@ func TestDoRequest_NotFound(t *testing.T) {
 		baseURL:    server.URL,
 	}
 
-	_, err := client.doRequest("/thing?id=999999")
+	_, err := client.doRequest(context.Background(), "/thing?id=999999")
 	if err == nil {
 		t.Fatal("expected error for 404 response")
 	}
@@ -159,7 +213,7 @@ func TestDoRequest_RetryOn202(t *testing.T) {
 		baseURL:    server.URL,
 	}
 
-	body, err := client.doRequest("/collection")
+	body, err := client.doRequest(context.Background(), "/collection")
 	if err != nil {
 		t.Fatalf("doRequest() error = %v", err)
 	}
@@ -193,7 +247,7 @@ func TestDoRequest_429WithRetry(t *testing.T) {
 		baseURL:    server.URL,
 	}
 
-	body, err := client.doRequest("/hot")
+	body, err := client.doRequest(context.Background(), "/hot")
 	if err != nil {
 		t.Fatalf("doRequest() error = %v", err)
 	}
@@ -217,7 +271,7 @@ func TestDoRequestWithRetryOn202_429ReturnsError(t *testing.T) {
 		baseURL:    server.URL,
 	}
 
-	_, err := client.doRequestWithRetryOn202("/collection", 1)
+	_, err := client.doRequestWithRetryOn202(context.Background(), "/collection", 1)
 	if err == nil {
 		t.Fatal("expected error for 429 in doRequestWithRetryOn202")
 	}
@@ -249,7 +303,7 @@ func TestDoRequest_503Retry(t *testing.T) {
 		baseURL:    server.URL,
 	}
 
-	body, err := client.doRequest("/hot")
+	body, err := client.doRequest(context.Background(), "/hot")
 	if err != nil {
 		t.Fatalf("doRequest() error = %v", err)
 	}
@@ -258,6 +312,77 @@ func TestDoRequest_503Retry(t *testing.T) {
 	}
 }
 
+func TestDoRequest_CancelDuringBackoff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		httpClient: server.Client(),
+		token:      "test-token",
+		retryCount: 3,
+		retryDelay: time.Hour,
+		baseURL:    server.URL,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.doRequestWithRetryOn202(ctx, "/collection", 10)
+	if err == nil {
+		t.Fatal("expected error after cancellation")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("cancellation took too long: %v", elapsed)
+	}
+}
+
+func TestDoRequest_CancelDuringRetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		httpClient: server.Client(),
+		token:      "test-token",
+		retryCount: 3,
+		retryDelay: 10 * time.Millisecond,
+		baseURL:    server.URL,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err := client.doRequest(ctx, "/hot")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestGetGameContext_Canceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected with a canceled context")
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GetGameContext(ctx, 13)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
 func TestErrorTypes(t *testing.T) {
 	t.Run("AuthError", func(t *testing.T) {
 		err := newAuthError("invalid token", nil)
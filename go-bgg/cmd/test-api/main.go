@@ -3,6 +3,7 @@ package main
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 
 	bgg "github.com/hiroaqii/go-bgg"
@@ -28,9 +29,24 @@ func main() {
 		username = os.Args[2]
 	}
 
-	client, err := bgg.NewClient(bgg.Config{
+	cfg := bgg.Config{
 		Token: token,
-	})
+	}
+
+	// Save every response as a fixture when BGG_RECORD_DIR is set
+	if dir := os.Getenv("BGG_RECORD_DIR"); dir != "" {
+		transport, err := bgg.NewRecordingTransport(dir, nil, func(err error) {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		})
+		if err != nil {
+			fmt.Printf("Error creating recorder: %v\n", err)
+			os.Exit(1)
+		}
+		cfg.HTTPClient = &http.Client{Transport: transport, Timeout: bgg.DefaultTimeout}
+		fmt.Printf("Recording responses to %s\n\n", dir)
+	}
+
+	client, err := bgg.NewClient(cfg)
 	if err != nil {
 		fmt.Printf("Error creating client: %v\n", err)
 		os.Exit(1)
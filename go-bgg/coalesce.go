@@ -0,0 +1,76 @@
+package bgg
+
+import (
+	"context"
+	"sync"
+)
+
+// flightGroup collapses identical concurrent requests into one call whose
+// result is shared by every caller. The zero value is ready to use.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+// flight is an in-progress call shared by its waiters.
+type flight struct {
+	done    chan struct{}
+	body    []byte
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// do calls fn once for all concurrent callers with the same key. fn runs on
+// a context that keeps the first caller's values and is canceled only when
+// every waiting caller has given up, so one caller's cancellation does not
+// fail the others.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, newNetworkError("request canceled", 0, err)
+	}
+
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flight)
+	}
+	f, ok := g.calls[key]
+	if !ok {
+		fctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = f
+		go func() {
+			f.body, f.err = fn(fctx)
+			cancel()
+			g.mu.Lock()
+			g.forget(key, f)
+			g.mu.Unlock()
+			close(f.done)
+		}()
+	}
+	f.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.body, f.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		f.waiters--
+		if f.waiters == 0 {
+			// Nobody is waiting anymore; later callers start afresh
+			f.cancel()
+			g.forget(key, f)
+		}
+		g.mu.Unlock()
+		return nil, newNetworkError("request canceled", 0, ctx.Err())
+	}
+}
+
+// forget removes f from the group if it is still the call for key.
+// g.mu must be held.
+func (g *flightGroup) forget(key string, f *flight) {
+	if g.calls[key] == f {
+		delete(g.calls, key)
+	}
+}
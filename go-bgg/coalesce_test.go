@@ -0,0 +1,210 @@
+package bgg
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newSlowThingServer serves the thing fixture after delay, counting requests
+// and the ones whose client went away.
+func newSlowThingServer(t *testing.T, delay time.Duration) (*httptest.Server, *int32, *int32) {
+	t.Helper()
+
+	testData, err := os.ReadFile("testdata/thing_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	var requests, aborted int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			atomic.AddInt32(&aborted, 1)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests, &aborted
+}
+
+func TestCoalesce_ConcurrentCalls(t *testing.T) {
+	server, requests, _ := newSlowThingServer(t, 50*time.Millisecond)
+	client := createTestClient(t, server)
+
+	var wg sync.WaitGroup
+	games := make([]*Game, 5)
+	errs := make([]error, 5)
+	for i := range games {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			games[i], errs[i] = client.GetGame(13)
+		}()
+	}
+	wg.Wait()
+
+	for i := range games {
+		if errs[i] != nil || games[i] == nil || games[i].ID != 13 {
+			t.Errorf("caller %d: got %v, %v", i, games[i], errs[i])
+		}
+	}
+	if n := atomic.LoadInt32(requests); n != 1 {
+		t.Errorf("expected 1 request for identical concurrent calls, got %d", n)
+	}
+
+	// Once the call is done, the next one goes to the server again
+	if _, err := client.GetGame(13); err != nil {
+		t.Fatalf("GetGame() error = %v", err)
+	}
+	if n := atomic.LoadInt32(requests); n != 2 {
+		t.Errorf("expected 2 requests, got %d", n)
+	}
+}
+
+func TestCoalesce_SharedError(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<errors><error><message>Invalid username specified</message></error></errors>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	// Each caller fills in the username of the shared error; run with -race
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = client.GetUser("nobody", UserOptions{})
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		var invalid *InvalidUsernameError
+		if !errors.As(err, &invalid) || invalid.Username != "nobody" {
+			t.Errorf("caller %d: expected InvalidUsernameError for 'nobody', got %v", i, err)
+		}
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("expected 1 request for identical concurrent calls, got %d", n)
+	}
+}
+
+func TestCoalesce_Hooks(t *testing.T) {
+	server, _, _ := newSlowThingServer(t, 50*time.Millisecond)
+	client := createTestClient(t, server)
+
+	var requests int32
+	client.hooks = HookFuncs{
+		Request: func(ctx context.Context, ev RequestEvent) { atomic.AddInt32(&requests, 1) },
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetGame(13); err != nil {
+				t.Errorf("GetGame() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The shared call is reported once, not once per caller
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("expected 1 OnRequest for coalesced calls, got %d", n)
+	}
+}
+
+func TestCoalesce_DifferentRequests(t *testing.T) {
+	server, requests, _ := newSlowThingServer(t, 20*time.Millisecond)
+	client := createTestClient(t, server)
+
+	var wg sync.WaitGroup
+	for _, id := range []int{13, 14} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.GetGame(id)
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(requests); n != 2 {
+		t.Errorf("expected 2 requests for different games, got %d", n)
+	}
+}
+
+func TestCoalesce_CancelOneCaller(t *testing.T) {
+	server, requests, aborted := newSlowThingServer(t, 100*time.Millisecond)
+	client := createTestClient(t, server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error, 1)
+	go func() {
+		_, err := client.GetGameContext(ctx, 13)
+		canceled <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.GetGame(13)
+		done <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	if err := <-canceled; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled for the canceled caller, got %v", err)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("expected the other caller to succeed, got %v", err)
+	}
+	if atomic.LoadInt32(requests) != 1 || atomic.LoadInt32(aborted) != 0 {
+		t.Errorf("expected one uninterrupted request, got %d requests, %d aborted", *requests, *aborted)
+	}
+}
+
+func TestCoalesce_CancelAllCallers(t *testing.T) {
+	server, _, aborted := newSlowThingServer(t, time.Second)
+	client := createTestClient(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := client.GetGameContext(ctx, 13); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected the call to return promptly, took %v", elapsed)
+	}
+
+	// The shared request is aborted once nobody waits for it
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt32(aborted) == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if atomic.LoadInt32(aborted) != 1 {
+		t.Error("expected the in-flight request to be aborted")
+	}
+}
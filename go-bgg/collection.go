@@ -1,22 +1,54 @@
 package bgg
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strconv"
+	"time"
 )
 
 const (
 	// Collection API max retries for 202 responses
 	collectionMaxRetries = 10
+
+	// collectionModifiedLayout is the format of the modifiedsince parameter,
+	// matching the lastmodified attribute of collection items
+	collectionModifiedLayout = "2006-01-02 15:04:05"
 )
 
 // GetCollection retrieves a user's game collection.
 func (c *Client) GetCollection(username string, opts CollectionOptions) ([]CollectionItem, error) {
+	return c.GetCollectionContext(context.Background(), username, opts)
+}
+
+// GetCollectionContext is like GetCollection but uses ctx for the request.
+func (c *Client) GetCollectionContext(ctx context.Context, username string, opts CollectionOptions) ([]CollectionItem, error) {
 	if username == "" {
 		return nil, newParseError("username is required", nil)
 	}
 
+	if opts.SplitExpansions && opts.ExcludeSubtype == "" && (opts.Subtype == "" || opts.Subtype == ThingBoardGame) {
+		if opts.Progress != nil {
+			opts.Progress = splitProgress(opts.Progress)
+		}
+
+		baseOpts := opts
+		baseOpts.ExcludeSubtype = ThingBoardGameExpansion
+		items, err := c.GetCollectionContext(ctx, username, baseOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		expansionOpts := opts
+		expansionOpts.Subtype = ThingBoardGameExpansion
+		expansions, err := c.GetCollectionContext(ctx, username, expansionOpts)
+		if err != nil {
+			return nil, err
+		}
+		return append(items, expansions...), nil
+	}
+
 	endpoint := fmt.Sprintf("/collection?username=%s&stats=1", url.QueryEscape(username))
 	for _, f := range []struct {
 		flag bool
@@ -30,15 +62,72 @@ func (c *Client) GetCollection(username string, opts CollectionOptions) ([]Colle
 		{opts.WantToBuy, "wanttobuy"},
 		{opts.Wishlist, "wishlist"},
 		{opts.Preordered, "preordered"},
+		{opts.Brief, "brief"},
+		{opts.Version, "version"},
+		{opts.Played, "played"},
+		{opts.Rated, "rated"},
+		{opts.Comment, "comment"},
+		{opts.HasParts, "hasparts"},
+		{opts.WantParts, "wantparts"},
 	} {
 		if f.flag {
 			endpoint += "&" + f.key + "=1"
 		}
 	}
 
-	body, err := c.doRequestWithRetryOn202(endpoint, collectionMaxRetries)
+	params := url.Values{}
+	if opts.Subtype != "" {
+		params.Set("subtype", string(opts.Subtype))
+	}
+	if opts.ExcludeSubtype != "" {
+		params.Set("excludesubtype", string(opts.ExcludeSubtype))
+	}
+	for _, f := range []struct {
+		value float64
+		key   string
+	}{
+		{opts.MinRating, "minrating"},
+		{opts.Rating, "rating"},
+		{opts.MinBGGRating, "minbggrating"},
+	} {
+		if f.value > 0 {
+			params.Set(f.key, strconv.FormatFloat(f.value, 'f', -1, 64))
+		}
+	}
+	if opts.MinPlays > 0 {
+		params.Set("minplays", strconv.Itoa(opts.MinPlays))
+	}
+	if opts.MaxPlays > 0 {
+		params.Set("maxplays", strconv.Itoa(opts.MaxPlays))
+	}
+	if !opts.ModifiedSince.IsZero() {
+		params.Set("modifiedsince", opts.ModifiedSince.Format(collectionModifiedLayout))
+	}
+	if len(params) > 0 {
+		endpoint += "&" + params.Encode()
+	}
+
+	reqOpts := c.retryOn202Options(endpoint, collectionMaxRetries)
+	if opts.Progress != nil {
+		start := time.Now()
+		maxAttempts := 0
+		if n, ok := maxRetriesOf(reqOpts.policy); ok {
+			maxAttempts = n + 1
+		}
+		reqOpts.onRetry = func(n int, delay time.Duration, err error) {
+			opts.Progress(CollectionProgress{
+				Attempt:     n,
+				MaxAttempts: maxAttempts,
+				Elapsed:     time.Since(start),
+				RetryIn:     delay,
+				Err:         err,
+			})
+		}
+	}
+
+	body, err := c.doRequestWithOpts(ctx, endpoint, reqOpts)
 	if err != nil {
-		return nil, err
+		return nil, withUsername(err, username)
 	}
 
 	xmlResp, err := parseXML[xmlCollection](body, "failed to parse collection response")
@@ -48,15 +137,38 @@ func (c *Client) GetCollection(username string, opts CollectionOptions) ([]Colle
 
 	items := make([]CollectionItem, 0, len(xmlResp.Items))
 	for _, item := range xmlResp.Items {
-		items = append(items, convertXMLToCollectionItem(item))
+		ci := convertXMLToCollectionItem(item)
+		if opts.Subtype == ThingBoardGameExpansion {
+			ci.Subtype = string(ThingBoardGameExpansion)
+		}
+		items = append(items, ci)
 	}
 
 	return items, nil
 }
 
+// splitProgress adapts progress to span the two requests of
+// SplitExpansions, so that attempts and elapsed time keep counting up.
+func splitProgress(progress func(CollectionProgress)) func(CollectionProgress) {
+	start := time.Now()
+	attempts := 0
+	return func(p CollectionProgress) {
+		attempts++
+		p.Attempt = attempts
+		p.MaxAttempts *= 2
+		p.Elapsed = time.Since(start)
+		progress(p)
+	}
+}
+
 // GetCollectionJSON retrieves a user's game collection and returns JSON.
 func (c *Client) GetCollectionJSON(username string, opts CollectionOptions) (string, error) {
-	items, err := c.GetCollection(username, opts)
+	return c.GetCollectionJSONContext(context.Background(), username, opts)
+}
+
+// GetCollectionJSONContext is like GetCollectionJSON but uses ctx for the request.
+func (c *Client) GetCollectionJSONContext(ctx context.Context, username string, opts CollectionOptions) (string, error) {
+	items, err := c.GetCollectionContext(ctx, username, opts)
 	if err != nil {
 		return "", err
 	}
@@ -95,6 +207,24 @@ func convertXMLToCollectionItem(item xmlCollectionItem) CollectionItem {
 
 	// Extract rank (board game rank)
 	ci.Rank = extractBoardGameRank(item.Stats.Rating.Ranks.Ranks)
+	ci.Ranks = convertXMLToRanks(item.Stats.Rating.Ranks.Ranks)
+
+	ci.CollID = item.CollID
+	ci.Subtype = item.Subtype
+	ci.MinPlayers = item.Stats.MinPlayers
+	ci.MaxPlayers = item.Stats.MaxPlayers
+	ci.MinPlayTime = item.Stats.MinPlayTime
+	ci.MaxPlayTime = item.Stats.MaxPlayTime
+	ci.PlayingTime = item.Stats.PlayingTime
+	ci.WishlistPriority = item.Status.WishlistPriority
+	ci.LastModified = item.Status.LastModified
+	ci.LastModifiedTime = parseTime(item.Status.LastModified)
+	ci.Comment = decodeHTML(item.Comment)
+
+	if item.Version != nil && len(item.Version.Items) > 0 {
+		v := convertXMLToVersion(item.Version.Items[0])
+		ci.Version = &v
+	}
 
 	return ci
 }
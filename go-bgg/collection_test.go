@@ -6,8 +6,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestGetCollection(t *testing.T) {
@@ -92,6 +95,30 @@ func TestGetCollection(t *testing.T) {
 	if items[0].Rank != 42 {
 		t.Errorf("expected Rank 42, got %d", items[0].Rank)
 	}
+	if len(items[0].Ranks) != 2 {
+		t.Fatalf("expected 2 ranks, got %d", len(items[0].Ranks))
+	}
+	if r := items[0].Ranks[1]; r.Domain != "familygames" || r.Value != 12 || r.Bayes != 6.95 {
+		t.Errorf("unexpected family rank: %+v", r)
+	}
+	if items[0].CollID != 12345678 {
+		t.Errorf("expected CollID 12345678, got %d", items[0].CollID)
+	}
+	if items[0].Subtype != "boardgame" {
+		t.Errorf("expected Subtype 'boardgame', got '%s'", items[0].Subtype)
+	}
+	if items[0].MinPlayers != 3 || items[0].MaxPlayers != 4 {
+		t.Errorf("expected players 3-4, got %d-%d", items[0].MinPlayers, items[0].MaxPlayers)
+	}
+	if items[0].MinPlayTime != 60 || items[0].MaxPlayTime != 120 || items[0].PlayingTime != 120 {
+		t.Errorf("unexpected play times: %d-%d (%d)", items[0].MinPlayTime, items[0].MaxPlayTime, items[0].PlayingTime)
+	}
+	if items[0].LastModified != "2024-01-15 10:30:00" {
+		t.Errorf("unexpected LastModified: '%s'", items[0].LastModified)
+	}
+	if items[0].Version != nil {
+		t.Error("expected Version to be nil when not requested")
+	}
 
 	// Verify wishlist item
 	if !items[2].Wishlist {
@@ -128,6 +155,240 @@ func TestGetCollection_OwnedOnly(t *testing.T) {
 	}
 }
 
+func TestGetCollection_AllOptions(t *testing.T) {
+	testData, err := os.ReadFile("testdata/collection_full_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		want := map[string]string{
+			"username":       "testuser",
+			"stats":          "1",
+			"wishlist":       "1",
+			"subtype":        "boardgameexpansion",
+			"excludesubtype": "boardgameaccessory",
+			"brief":          "1",
+			"version":        "1",
+			"minrating":      "6.5",
+			"rating":         "9",
+			"minbggrating":   "7",
+			"minplays":       "1",
+			"maxplays":       "10",
+			"played":         "1",
+			"rated":          "1",
+			"comment":        "1",
+			"hasparts":       "1",
+			"wantparts":      "1",
+			"modifiedsince":  "2024-06-01 12:30:00",
+		}
+		for k, v := range want {
+			if got := q.Get(k); got != v {
+				t.Errorf("expected %s '%s', got '%s'", k, v, got)
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	items, err := client.GetCollection("testuser", CollectionOptions{
+		Wishlist:       true,
+		Subtype:        ThingBoardGameExpansion,
+		ExcludeSubtype: ThingBoardGameAccessory,
+		Brief:          true,
+		Version:        true,
+		MinRating:      6.5,
+		Rating:         9,
+		MinBGGRating:   7,
+		MinPlays:       1,
+		MaxPlays:       10,
+		Played:         true,
+		Rated:          true,
+		Comment:        true,
+		HasParts:       true,
+		WantParts:      true,
+		ModifiedSince:  time.Date(2024, 6, 1, 12, 30, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("GetCollection failed: %v", err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %d", len(items))
+	}
+
+	item := items[0]
+	if item.Subtype != "boardgameexpansion" {
+		t.Errorf("expected Subtype 'boardgameexpansion', got '%s'", item.Subtype)
+	}
+	if item.CollID != 34567890 {
+		t.Errorf("expected CollID 34567890, got %d", item.CollID)
+	}
+	if item.WishlistPriority != 2 {
+		t.Errorf("expected WishlistPriority 2, got %d", item.WishlistPriority)
+	}
+	if item.LastModified != "2024-11-03 08:15:42" {
+		t.Errorf("unexpected LastModified: '%s'", item.LastModified)
+	}
+	if want := time.Date(2024, 11, 3, 8, 15, 42, 0, time.UTC); !item.LastModifiedTime.Equal(want) {
+		t.Errorf("unexpected LastModifiedTime: %v", item.LastModifiedTime)
+	}
+	if item.Comment != "Played at a friend's place, want my own copy." {
+		t.Errorf("unexpected Comment: '%s'", item.Comment)
+	}
+	if item.Rating != 7.5 {
+		t.Errorf("expected Rating 7.5, got %f", item.Rating)
+	}
+	if item.Version == nil {
+		t.Fatal("expected Version to be non-nil")
+	}
+	if item.Version.ID != 41234 || item.Version.Name != "English edition" || item.Version.ProductCode != "CN3077" {
+		t.Errorf("unexpected Version: %+v", item.Version)
+	}
+	if len(item.Version.Languages) != 1 || item.Version.Languages[0] != "English" {
+		t.Errorf("expected Languages [English], got %v", item.Version.Languages)
+	}
+}
+
+func TestGetCollection_NoExtraOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, k := range []string{"subtype", "excludesubtype", "brief", "version", "minrating", "rating",
+			"minbggrating", "minplays", "maxplays", "played", "rated", "comment", "hasparts", "wantparts", "modifiedsince"} {
+			if r.URL.Query().Has(k) {
+				t.Errorf("expected no %s parameter", k)
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<items totalitems="0"></items>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	if _, err := client.GetCollection("testuser", CollectionOptions{}); err != nil {
+		t.Fatalf("GetCollection failed: %v", err)
+	}
+}
+
+func TestGetCollection_SplitExpansions(t *testing.T) {
+	testData, err := os.ReadFile("testdata/collection_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+	expansionData, err := os.ReadFile("testdata/collection_expansions_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	var mu sync.Mutex
+	var queries []string
+	pending := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		key := "subtype=" + q.Get("subtype") + "&excludesubtype=" + q.Get("excludesubtype")
+		mu.Lock()
+		queries = append(queries, key)
+		pending[key]++
+		accepted := pending[key] == 1
+		mu.Unlock()
+
+		// Each request is answered with 202 once
+		if accepted {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if q.Get("subtype") == "boardgameexpansion" {
+			w.Write(expansionData)
+			return
+		}
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+	client.retryDelay = time.Millisecond
+
+	var progress []CollectionProgress
+	items, err := client.GetCollection("testuser", CollectionOptions{
+		SplitExpansions: true,
+		Progress:        func(p CollectionProgress) { progress = append(progress, p) },
+	})
+	if err != nil {
+		t.Fatalf("GetCollection failed: %v", err)
+	}
+
+	if len(items) != 4 {
+		t.Fatalf("expected 3 base games and 1 expansion, got %d items", len(items))
+	}
+	if items[0].Subtype != "boardgame" {
+		t.Errorf("expected base game Subtype 'boardgame', got '%s'", items[0].Subtype)
+	}
+	// The fixture reports the expansion as "boardgame"; it is tagged from the request
+	if items[3].ID != 325 || items[3].Subtype != "boardgameexpansion" {
+		t.Errorf("expected expansion 325 last with Subtype 'boardgameexpansion', got %d '%s'", items[3].ID, items[3].Subtype)
+	}
+
+	want := "subtype=&excludesubtype=boardgameexpansion,subtype=&excludesubtype=boardgameexpansion," +
+		"subtype=boardgameexpansion&excludesubtype=,subtype=boardgameexpansion&excludesubtype="
+	if got := strings.Join(queries, ","); got != want {
+		t.Errorf("expected requests %s, got %s", want, got)
+	}
+
+	// Progress keeps counting across both requests
+	if len(progress) != 2 {
+		t.Fatalf("expected 2 progress reports, got %d", len(progress))
+	}
+	for i, p := range progress {
+		if p.Attempt != i+1 || p.MaxAttempts != 2*(collectionMaxRetries+1) {
+			t.Errorf("progress[%d] = %+v", i, p)
+		}
+	}
+	if progress[1].Elapsed < progress[0].Elapsed {
+		t.Errorf("expected elapsed time to keep counting, got %v then %v", progress[0].Elapsed, progress[1].Elapsed)
+	}
+}
+
+func TestGetCollection_SplitExpansionsIgnored(t *testing.T) {
+	testData, err := os.ReadFile("testdata/collection_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	var requestCount int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requestCount, 1)
+		if got := r.URL.Query().Get("excludesubtype"); got != "boardgameaccessory" {
+			t.Errorf("expected excludesubtype 'boardgameaccessory', got '%s'", got)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	// An explicit filter is sent as is, in a single request
+	items, err := client.GetCollection("testuser", CollectionOptions{
+		SplitExpansions: true,
+		ExcludeSubtype:  ThingBoardGameAccessory,
+	})
+	if err != nil {
+		t.Fatalf("GetCollection failed: %v", err)
+	}
+	if len(items) != 3 {
+		t.Errorf("expected 3 items, got %d", len(items))
+	}
+	if requestCount != 1 {
+		t.Errorf("expected 1 request, got %d", requestCount)
+	}
+}
+
 func TestGetCollection_EmptyUsername(t *testing.T) {
 	client, _ := NewClient(Config{Token: "test-token"})
 
@@ -181,6 +442,107 @@ func TestGetCollection_RetryOn202(t *testing.T) {
 	}
 }
 
+func TestGetCollection_Progress(t *testing.T) {
+	testData, err := os.ReadFile("testdata/collection_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	var requestCount int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requestCount, 1) <= 3 {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+	client.retryDelay = 10 * time.Millisecond
+
+	var progress []CollectionProgress
+	_, err = client.GetCollection("testuser", CollectionOptions{
+		Progress: func(p CollectionProgress) { progress = append(progress, p) },
+	})
+	if err != nil {
+		t.Fatalf("GetCollection failed: %v", err)
+	}
+
+	if len(progress) != 3 {
+		t.Fatalf("expected 3 progress reports, got %d", len(progress))
+	}
+	for i, p := range progress {
+		if p.Attempt != i+1 || p.MaxAttempts != collectionMaxRetries+1 || p.RetryIn != 10*time.Millisecond {
+			t.Errorf("progress[%d] = %+v", i, p)
+		}
+		var processing *ProcessingError
+		if !errors.As(p.Err, &processing) {
+			t.Errorf("progress[%d].Err = %v, want ProcessingError", i, p.Err)
+		}
+	}
+	if progress[2].Elapsed < 20*time.Millisecond {
+		t.Errorf("expected elapsed time to include earlier retries, got %v", progress[2].Elapsed)
+	}
+}
+
+func TestGetCollection_InvalidUsername(t *testing.T) {
+	var requestCount int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requestCount, 1)
+		// BGG reports unknown users with a 200 error document
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<?xml version="1.0" encoding="utf-8" standalone="yes"?>
+<errors><error><message>Invalid username specified</message></error></errors>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+	client.cache = NewMemoryCache()
+
+	for i := 0; i < 2; i++ {
+		items, err := client.GetCollection("nosuchuser", CollectionOptions{})
+		if items != nil {
+			t.Errorf("expected no items, got %d", len(items))
+		}
+
+		var invalid *InvalidUsernameError
+		if !errors.As(err, &invalid) {
+			t.Fatalf("expected InvalidUsernameError, got %T: %v", err, err)
+		}
+		if invalid.Username != "nosuchuser" {
+			t.Errorf("expected username 'nosuchuser', got %q", invalid.Username)
+		}
+	}
+
+	// Error documents must not be cached
+	if requestCount != 2 {
+		t.Errorf("expected 2 requests, got %d", requestCount)
+	}
+}
+
+func TestGetCollection_StillProcessing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`<message>Your request for this collection has been accepted and will be processed.  Please try again later for access.</message>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+	client.retryDelay = time.Millisecond
+
+	_, err := client.GetCollection("testuser", CollectionOptions{})
+
+	var processing *ProcessingError
+	if !errors.As(err, &processing) {
+		t.Fatalf("expected ProcessingError, got %T: %v", err, err)
+	}
+	if !strings.Contains(processing.Message, "has been accepted") {
+		t.Errorf("unexpected message: %q", processing.Message)
+	}
+}
+
 func TestGetCollectionJSON(t *testing.T) {
 	testData, err := os.ReadFile("testdata/collection_response.xml")
 	if err != nil {
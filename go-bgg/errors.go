@@ -2,6 +2,7 @@ package bgg
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -93,6 +94,67 @@ func (e *ParseError) Unwrap() error {
 	return e.Cause
 }
 
+// APIError represents an error document BGG returned instead of data,
+// typically with a 200 status.
+type APIError struct {
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return "bgg api error: " + e.Message
+}
+
+// InvalidUsernameError is returned when BGG does not know the requested user.
+type InvalidUsernameError struct {
+	Username string
+	Message  string
+}
+
+func (e *InvalidUsernameError) Error() string {
+	if e.Username != "" {
+		return fmt.Sprintf("user %q not found", e.Username)
+	}
+	return e.Message
+}
+
+// ProcessingError is returned when BGG has queued the request and is still
+// preparing the data (a 202 Accepted response or an "accepted" message body)
+// after all retries. Trying again later usually succeeds.
+type ProcessingError struct {
+	Message string
+}
+
+func (e *ProcessingError) Error() string {
+	return e.Message
+}
+
+// BatchFailure is a batch of IDs that could not be retrieved.
+type BatchFailure struct {
+	IDs []int
+	Err error
+}
+
+// BatchError reports the failed batches of a batched request.
+// Results from the other batches are still returned alongside it.
+type BatchError struct {
+	Batches  int // total number of batches
+	Failures []BatchFailure
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("%d of %d batches failed: %v", len(e.Failures), e.Batches, e.Failures[0].Err)
+}
+
+// Unwrap returns the errors of the failed batches, so errors.As finds
+// e.g. an AuthError from any of them.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, f := range e.Failures {
+		errs[i] = f.Err
+	}
+	return errs
+}
+
 // newAuthError creates a new AuthError.
 func newAuthError(message string, cause error) *AuthError {
 	return &AuthError{
@@ -117,6 +179,34 @@ func newNotFoundError(id int) *NotFoundError {
 	}
 }
 
+// newAPIError creates an error for a BGG error document message, using a
+// more specific type where the message is recognized.
+func newAPIError(message string) error {
+	if strings.Contains(strings.ToLower(message), "invalid username") {
+		return &InvalidUsernameError{Message: message}
+	}
+	return &APIError{Message: message}
+}
+
+// newProcessingError creates a new ProcessingError.
+func newProcessingError(message string) *ProcessingError {
+	if message == "" {
+		message = "request accepted but not ready, retry later"
+	}
+	return &ProcessingError{Message: message}
+}
+
+// withUsername returns a copy of an InvalidUsernameError with the username
+// filled in. The original is left alone, as coalesced requests share it.
+func withUsername(err error, username string) error {
+	if invalid, ok := err.(*InvalidUsernameError); ok {
+		e := *invalid
+		e.Username = username
+		return &e
+	}
+	return err
+}
+
 // newNetworkError creates a new NetworkError.
 func newNetworkError(message string, statusCode int, cause error) *NetworkError {
 	return &NetworkError{
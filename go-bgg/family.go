@@ -0,0 +1,88 @@
+package bgg
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GetFamily retrieves one or more families (e.g. "Series: Catan") with their member things.
+func (c *Client) GetFamily(ids []int) ([]Family, error) {
+	return c.GetFamilyContext(context.Background(), ids)
+}
+
+// GetFamilyContext is like GetFamily but uses ctx for the request.
+func (c *Client) GetFamilyContext(ctx context.Context, ids []int) ([]Family, error) {
+	if len(ids) == 0 {
+		return []Family{}, nil
+	}
+
+	// Build comma-separated ID list
+	idStrs := make([]string, len(ids))
+	for i, id := range ids {
+		idStrs[i] = strconv.Itoa(id)
+	}
+
+	endpoint := fmt.Sprintf("/family?id=%s", strings.Join(idStrs, ","))
+
+	body, err := c.doRequest(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	xmlResp, err := parseXML[xmlFamily](body, "failed to parse family response")
+	if err != nil {
+		return nil, err
+	}
+
+	families := make([]Family, 0, len(xmlResp.Items))
+	for _, item := range xmlResp.Items {
+		families = append(families, convertXMLToFamily(item))
+	}
+
+	return families, nil
+}
+
+// GetFamilyJSON retrieves one or more families and returns JSON.
+func (c *Client) GetFamilyJSON(ids []int) (string, error) {
+	return c.GetFamilyJSONContext(context.Background(), ids)
+}
+
+// GetFamilyJSONContext is like GetFamilyJSON but uses ctx for the request.
+func (c *Client) GetFamilyJSONContext(ctx context.Context, ids []int) (string, error) {
+	families, err := c.GetFamilyContext(ctx, ids)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(families)
+}
+
+// convertXMLToFamily converts an XML family item to a Family struct.
+func convertXMLToFamily(item xmlFamilyItem) Family {
+	family := Family{
+		ID:          item.ID,
+		Type:        item.Type,
+		Description: decodeHTML(item.Description),
+		Thumbnail:   item.Thumbnail,
+		Image:       item.Image,
+		Members:     make([]FamilyMember, 0, len(item.Links)),
+	}
+
+	// Get primary name
+	for _, name := range item.Names {
+		if name.Type == "primary" {
+			family.Name = name.Value
+			break
+		}
+	}
+
+	for _, link := range item.Links {
+		family.Members = append(family.Members, FamilyMember{
+			ID:   link.ID,
+			Name: link.Value,
+		})
+	}
+
+	return family
+}
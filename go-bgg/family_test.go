@@ -0,0 +1,117 @@
+package bgg
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestGetFamily(t *testing.T) {
+	testData, err := os.ReadFile("testdata/family_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request path
+		if r.URL.Path != "/family" {
+			t.Errorf("expected path '/family', got '%s'", r.URL.Path)
+		}
+
+		// Verify comma-separated IDs
+		id := r.URL.Query().Get("id")
+		if id != "3,5452" {
+			t.Errorf("expected id '3,5452', got '%s'", id)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	families, err := client.GetFamily([]int{3, 5452})
+	if err != nil {
+		t.Fatalf("GetFamily failed: %v", err)
+	}
+
+	if len(families) != 2 {
+		t.Fatalf("expected 2 families, got %d", len(families))
+	}
+
+	catan := families[0]
+	if catan.ID != 3 {
+		t.Errorf("expected ID 3, got %d", catan.ID)
+	}
+	if catan.Type != "boardgamefamily" {
+		t.Errorf("expected Type 'boardgamefamily', got '%s'", catan.Type)
+	}
+	if catan.Name != "Series: Catan" {
+		t.Errorf("expected Name 'Series: Catan', got '%s'", catan.Name)
+	}
+	if catan.Description != "Games in the Catan series.\n\nIncludes spin-offs and expansions." {
+		t.Errorf("unexpected Description: %q", catan.Description)
+	}
+	if catan.Thumbnail == "" {
+		t.Error("expected non-empty Thumbnail")
+	}
+	if len(catan.Members) != 3 {
+		t.Fatalf("expected 3 members, got %d", len(catan.Members))
+	}
+	if catan.Members[0].ID != 13 || catan.Members[0].Name != "CATAN" {
+		t.Errorf("unexpected first member: %+v", catan.Members[0])
+	}
+
+	if families[1].Name != "Mechanism: Deck Building" {
+		t.Errorf("expected Name 'Mechanism: Deck Building', got '%s'", families[1].Name)
+	}
+	if len(families[1].Members) != 1 {
+		t.Errorf("expected 1 member, got %d", len(families[1].Members))
+	}
+}
+
+func TestGetFamily_Empty(t *testing.T) {
+	client, _ := NewClient(Config{Token: "test-token"})
+
+	families, err := client.GetFamily([]int{})
+	if err != nil {
+		t.Fatalf("GetFamily failed: %v", err)
+	}
+
+	if len(families) != 0 {
+		t.Errorf("expected 0 families, got %d", len(families))
+	}
+}
+
+func TestGetFamilyJSON(t *testing.T) {
+	testData, err := os.ReadFile("testdata/family_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	jsonStr, err := client.GetFamilyJSON([]int{3})
+	if err != nil {
+		t.Fatalf("GetFamilyJSON failed: %v", err)
+	}
+
+	// Verify it's valid JSON
+	var families []Family
+	if err := json.Unmarshal([]byte(jsonStr), &families); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+
+	if len(families) != 2 {
+		t.Errorf("expected 2 families, got %d", len(families))
+	}
+}
@@ -1,17 +1,28 @@
 package bgg
 
 import (
+	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 )
 
+// threadDateLayout is the format of the minarticledate parameter.
+const threadDateLayout = "2006-01-02 15:04:05"
+
 // GetForums retrieves the list of forums for a game.
 func (c *Client) GetForums(gameID int) ([]Forum, error) {
+	return c.GetForumsContext(context.Background(), gameID)
+}
+
+// GetForumsContext is like GetForums but uses ctx for the request.
+func (c *Client) GetForumsContext(ctx context.Context, gameID int) ([]Forum, error) {
 	if gameID <= 0 {
 		return nil, newNotFoundError(gameID)
 	}
 
 	endpoint := fmt.Sprintf("/forumlist?type=thing&id=%d", gameID)
-	body, err := c.doRequest(endpoint)
+	body, err := c.doRequest(ctx, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -30,6 +41,7 @@ func (c *Client) GetForums(gameID int) ([]Forum, error) {
 			NumThreads:   f.NumThreads,
 			NumPosts:     f.NumPosts,
 			LastPostDate: f.LastPostDate,
+			LastPostTime: parseTime(f.LastPostDate),
 		})
 	}
 
@@ -38,7 +50,12 @@ func (c *Client) GetForums(gameID int) ([]Forum, error) {
 
 // GetForumsJSON retrieves the list of forums for a game and returns JSON.
 func (c *Client) GetForumsJSON(gameID int) (string, error) {
-	forums, err := c.GetForums(gameID)
+	return c.GetForumsJSONContext(context.Background(), gameID)
+}
+
+// GetForumsJSONContext is like GetForumsJSON but uses ctx for the request.
+func (c *Client) GetForumsJSONContext(ctx context.Context, gameID int) (string, error) {
+	forums, err := c.GetForumsContext(ctx, gameID)
 	if err != nil {
 		return "", err
 	}
@@ -48,6 +65,11 @@ func (c *Client) GetForumsJSON(gameID int) (string, error) {
 // GetForumThreads retrieves threads in a forum.
 // Page is 1-indexed. Each page returns up to 50 threads.
 func (c *Client) GetForumThreads(forumID int, page int) (*ThreadList, error) {
+	return c.GetForumThreadsContext(context.Background(), forumID, page)
+}
+
+// GetForumThreadsContext is like GetForumThreads but uses ctx for the request.
+func (c *Client) GetForumThreadsContext(ctx context.Context, forumID int, page int) (*ThreadList, error) {
 	if forumID <= 0 {
 		return nil, newNotFoundError(forumID)
 	}
@@ -56,7 +78,7 @@ func (c *Client) GetForumThreads(forumID int, page int) (*ThreadList, error) {
 	}
 
 	endpoint := fmt.Sprintf("/forum?id=%d&page=%d", forumID, page)
-	body, err := c.doRequest(endpoint)
+	body, err := c.doRequest(ctx, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +96,9 @@ func (c *Client) GetForumThreads(forumID int, page int) (*ThreadList, error) {
 			Author:       t.Author,
 			NumArticles:  t.NumArticles,
 			PostDate:     t.PostDate,
+			PostTime:     parseTime(t.PostDate),
 			LastPostDate: t.LastPostDate,
+			LastPostTime: parseTime(t.LastPostDate),
 		})
 	}
 
@@ -94,7 +118,12 @@ func (c *Client) GetForumThreads(forumID int, page int) (*ThreadList, error) {
 
 // GetForumThreadsJSON retrieves threads in a forum and returns JSON.
 func (c *Client) GetForumThreadsJSON(forumID int, page int) (string, error) {
-	threadList, err := c.GetForumThreads(forumID, page)
+	return c.GetForumThreadsJSONContext(context.Background(), forumID, page)
+}
+
+// GetForumThreadsJSONContext is like GetForumThreadsJSON but uses ctx for the request.
+func (c *Client) GetForumThreadsJSONContext(ctx context.Context, forumID int, page int) (string, error) {
+	threadList, err := c.GetForumThreadsContext(ctx, forumID, page)
 	if err != nil {
 		return "", err
 	}
@@ -103,12 +132,41 @@ func (c *Client) GetForumThreadsJSON(forumID int, page int) (string, error) {
 
 // GetThread retrieves a thread with its articles.
 func (c *Client) GetThread(threadID int) (*Thread, error) {
+	return c.GetThreadContext(context.Background(), threadID)
+}
+
+// GetThreadContext is like GetThread but uses ctx for the request.
+func (c *Client) GetThreadContext(ctx context.Context, threadID int) (*Thread, error) {
+	return c.GetThreadWithOptionsContext(ctx, threadID, ThreadOptions{})
+}
+
+// GetThreadWithOptions retrieves a slice of a thread's articles. To page
+// through a large thread, set Count and pass the last article ID + 1 as
+// MinArticleID for the next call while HasMore is true.
+func (c *Client) GetThreadWithOptions(threadID int, opts ThreadOptions) (*Thread, error) {
+	return c.GetThreadWithOptionsContext(context.Background(), threadID, opts)
+}
+
+// GetThreadWithOptionsContext is like GetThreadWithOptions but uses ctx for the request.
+func (c *Client) GetThreadWithOptionsContext(ctx context.Context, threadID int, opts ThreadOptions) (*Thread, error) {
 	if threadID <= 0 {
 		return nil, newNotFoundError(threadID)
 	}
 
-	endpoint := fmt.Sprintf("/thread?id=%d", threadID)
-	body, err := c.doRequest(endpoint)
+	params := url.Values{}
+	params.Set("id", strconv.Itoa(threadID))
+	if opts.MinArticleID > 0 {
+		params.Set("minarticleid", strconv.Itoa(opts.MinArticleID))
+	}
+	if !opts.MinArticleDate.IsZero() {
+		params.Set("minarticledate", opts.MinArticleDate.Format(threadDateLayout))
+	}
+	if opts.Count > 0 {
+		params.Set("count", strconv.Itoa(opts.Count))
+	}
+
+	endpoint := fmt.Sprintf("/thread?%s", params.Encode())
+	body, err := c.doRequest(ctx, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -124,20 +182,64 @@ func (c *Client) GetThread(threadID int) (*Thread, error) {
 			ID:       a.ID,
 			Username: a.Username,
 			PostDate: a.PostDate,
+			PostTime: parseTime(a.PostDate),
 			Body:     decodeHTML(a.Body),
 		})
 	}
 
-	return &Thread{
-		ID:       xmlResp.ID,
-		Subject:  xmlResp.Subject,
-		Articles: articles,
-	}, nil
+	thread := &Thread{
+		ID:          xmlResp.ID,
+		Subject:     xmlResp.Subject,
+		Articles:    articles,
+		NumArticles: xmlResp.NumArticles,
+		TotalPages:  1,
+	}
+
+	fromStart := opts.MinArticleID <= 0 && opts.MinArticleDate.IsZero()
+	switch {
+	case fromStart:
+		thread.HasMore = thread.NumArticles > len(articles)
+	case opts.Count > 0:
+		thread.HasMore = len(articles) >= opts.Count
+	}
+	if fromStart {
+		thread.Page = 1
+	}
+
+	// Without a count, the whole response is treated as one page
+	perPage := opts.Count
+	if perPage <= 0 {
+		perPage = len(articles)
+	}
+	if perPage > 0 && thread.NumArticles > perPage {
+		thread.TotalPages = (thread.NumArticles + perPage - 1) / perPage
+	}
+
+	return thread, nil
+}
+
+// GetThreadWithOptionsJSON retrieves a slice of a thread's articles and returns JSON.
+func (c *Client) GetThreadWithOptionsJSON(threadID int, opts ThreadOptions) (string, error) {
+	return c.GetThreadWithOptionsJSONContext(context.Background(), threadID, opts)
+}
+
+// GetThreadWithOptionsJSONContext is like GetThreadWithOptionsJSON but uses ctx for the request.
+func (c *Client) GetThreadWithOptionsJSONContext(ctx context.Context, threadID int, opts ThreadOptions) (string, error) {
+	thread, err := c.GetThreadWithOptionsContext(ctx, threadID, opts)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(thread)
 }
 
 // GetThreadJSON retrieves a thread with its articles and returns JSON.
 func (c *Client) GetThreadJSON(threadID int) (string, error) {
-	thread, err := c.GetThread(threadID)
+	return c.GetThreadJSONContext(context.Background(), threadID)
+}
+
+// GetThreadJSONContext is like GetThreadJSON but uses ctx for the request.
+func (c *Client) GetThreadJSONContext(ctx context.Context, threadID int) (string, error) {
+	thread, err := c.GetThreadContext(ctx, threadID)
 	if err != nil {
 		return "", err
 	}
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 )
 
 func TestGetForums(t *testing.T) {
@@ -61,6 +62,9 @@ func TestGetForums(t *testing.T) {
 	if forums[0].NumPosts != 450 {
 		t.Errorf("expected NumPosts 450, got %d", forums[0].NumPosts)
 	}
+	if want := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC); !forums[0].LastPostTime.Equal(want) {
+		t.Errorf("expected LastPostTime %v, got %v", want, forums[0].LastPostTime)
+	}
 
 	// Verify third forum (General)
 	if forums[2].Title != "General" {
@@ -158,6 +162,9 @@ func TestGetForumThreads(t *testing.T) {
 	if threadList.Threads[0].ID != 1001 {
 		t.Errorf("expected ID 1001, got %d", threadList.Threads[0].ID)
 	}
+	if want := time.Date(2024, 12, 25, 8, 0, 0, 0, time.UTC); !threadList.Threads[0].PostTime.Equal(want) {
+		t.Errorf("expected PostTime %v, got %v", want, threadList.Threads[0].PostTime)
+	}
 	if threadList.Threads[0].Subject != "Best strategy for beginners?" {
 		t.Errorf("expected subject 'Best strategy for beginners?', got '%s'", threadList.Threads[0].Subject)
 	}
@@ -306,6 +313,96 @@ func TestGetThread(t *testing.T) {
 	if thread.Articles[1].Body[len(thread.Articles[1].Body)-1] != '!' {
 		t.Error("expected body to end with '!'")
 	}
+
+	// Verify paging fields for a complete thread
+	if thread.NumArticles != 3 {
+		t.Errorf("expected NumArticles 3, got %d", thread.NumArticles)
+	}
+	if thread.Page != 1 || thread.TotalPages != 1 {
+		t.Errorf("expected page 1/1, got %d/%d", thread.Page, thread.TotalPages)
+	}
+	if thread.HasMore {
+		t.Error("expected HasMore to be false")
+	}
+}
+
+func TestGetThreadWithOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		want := map[string]string{
+			"id":             "1001",
+			"minarticleid":   "5003",
+			"minarticledate": "2024-12-25 09:00:00",
+			"count":          "2",
+		}
+		for k, v := range want {
+			if got := q.Get(k); got != v {
+				t.Errorf("expected %s '%s', got '%s'", k, v, got)
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<thread id="1001" numarticles="7"><subject>Long thread</subject><articles>
+			<article id="5003" username="a" postdate="2024-12-25T09:00:00+00:00"><body>3</body></article>
+			<article id="5004" username="b" postdate="2024-12-25T10:00:00+00:00"><body>4</body></article>
+		</articles></thread>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	thread, err := client.GetThreadWithOptions(1001, ThreadOptions{
+		MinArticleID:   5003,
+		MinArticleDate: time.Date(2024, 12, 25, 9, 0, 0, 0, time.UTC),
+		Count:          2,
+	})
+	if err != nil {
+		t.Fatalf("GetThreadWithOptions failed: %v", err)
+	}
+
+	if len(thread.Articles) != 2 {
+		t.Fatalf("expected 2 articles, got %d", len(thread.Articles))
+	}
+	if thread.NumArticles != 7 {
+		t.Errorf("expected NumArticles 7, got %d", thread.NumArticles)
+	}
+	if thread.TotalPages != 4 {
+		t.Errorf("expected TotalPages 4, got %d", thread.TotalPages)
+	}
+	if thread.Page != 0 {
+		t.Errorf("expected Page 0 for a mid-thread fetch, got %d", thread.Page)
+	}
+	if !thread.HasMore {
+		t.Error("expected HasMore to be true")
+	}
+}
+
+func TestGetThreadWithOptions_FirstPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("minarticleid") || r.URL.Query().Has("minarticledate") {
+			t.Error("expected no minarticleid or minarticledate parameter")
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<thread id="1001" numarticles="3"><subject>Short</subject><articles>
+			<article id="5001" username="a"><body>1</body></article>
+			<article id="5002" username="b"><body>2</body></article>
+		</articles></thread>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	thread, err := client.GetThreadWithOptions(1001, ThreadOptions{Count: 2})
+	if err != nil {
+		t.Fatalf("GetThreadWithOptions failed: %v", err)
+	}
+
+	if thread.Page != 1 || thread.TotalPages != 2 {
+		t.Errorf("expected page 1/2, got %d/%d", thread.Page, thread.TotalPages)
+	}
+	if !thread.HasMore {
+		t.Error("expected HasMore to be true")
+	}
 }
 
 func TestGetThread_InvalidID(t *testing.T) {
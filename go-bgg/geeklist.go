@@ -0,0 +1,105 @@
+package bgg
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetGeekList retrieves a GeekList with its items.
+// If withComments is true, comments on the list and its items are included.
+// GeekLists are served by the XML API v1.
+func (c *Client) GetGeekList(id int, withComments bool) (*GeekList, error) {
+	return c.GetGeekListContext(context.Background(), id, withComments)
+}
+
+// GetGeekListContext is like GetGeekList but uses ctx for the request.
+func (c *Client) GetGeekListContext(ctx context.Context, id int, withComments bool) (*GeekList, error) {
+	if id <= 0 {
+		return nil, newNotFoundError(id)
+	}
+
+	endpoint := fmt.Sprintf("/geeklist/%d", id)
+	if withComments {
+		endpoint += "?comments=1"
+	}
+
+	body, err := c.doLegacyRequest(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	xmlResp, err := parseXML[xmlGeekList](body, "failed to parse geeklist response")
+	if err != nil {
+		return nil, err
+	}
+
+	if xmlResp.ID == 0 {
+		return nil, newNotFoundError(id)
+	}
+
+	items := make([]GeekListItem, 0, len(xmlResp.Items))
+	for _, item := range xmlResp.Items {
+		items = append(items, GeekListItem{
+			ID:         item.ID,
+			ObjectID:   item.ObjectID,
+			ObjectType: item.ObjectType,
+			Subtype:    item.Subtype,
+			ObjectName: item.ObjectName,
+			Username:   item.Username,
+			Body:       decodeHTML(item.Body),
+			Thumbs:     item.Thumbs,
+			ImageID:    item.ImageID,
+			PostDate:   item.PostDate,
+			EditDate:   item.EditDate,
+			PostTime:   parseTime(item.PostDate),
+			EditTime:   parseTime(item.EditDate),
+			Comments:   convertXMLToGeekListComments(item.Comments),
+		})
+	}
+
+	return &GeekList{
+		ID:          xmlResp.ID,
+		Title:       xmlResp.Title,
+		Username:    xmlResp.Username,
+		Description: decodeHTML(xmlResp.Description),
+		PostDate:    xmlResp.PostDate,
+		EditDate:    xmlResp.EditDate,
+		PostTime:    parseTime(xmlResp.PostDate),
+		EditTime:    parseTime(xmlResp.EditDate),
+		Thumbs:      xmlResp.Thumbs,
+		NumItems:    xmlResp.NumItems,
+		Items:       items,
+		Comments:    convertXMLToGeekListComments(xmlResp.Comments),
+	}, nil
+}
+
+// GetGeekListJSON retrieves a GeekList with its items and returns JSON.
+func (c *Client) GetGeekListJSON(id int, withComments bool) (string, error) {
+	return c.GetGeekListJSONContext(context.Background(), id, withComments)
+}
+
+// GetGeekListJSONContext is like GetGeekListJSON but uses ctx for the request.
+func (c *Client) GetGeekListJSONContext(ctx context.Context, id int, withComments bool) (string, error) {
+	geekList, err := c.GetGeekListContext(ctx, id, withComments)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(geekList)
+}
+
+// convertXMLToGeekListComments converts XML geeklist comments to GeekListComment structs.
+func convertXMLToGeekListComments(comments []xmlGeekListComment) []GeekListComment {
+	var result []GeekListComment
+	for _, c := range comments {
+		result = append(result, GeekListComment{
+			Username: c.Username,
+			PostDate: c.PostDate,
+			EditDate: c.EditDate,
+			PostTime: parseTime(c.PostDate),
+			EditTime: parseTime(c.EditDate),
+			Thumbs:   c.Thumbs,
+			Body:     decodeHTML(c.Body),
+		})
+	}
+	return result
+}
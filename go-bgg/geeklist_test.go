@@ -0,0 +1,196 @@
+package bgg
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestGetGeekList(t *testing.T) {
+	testData, err := os.ReadFile("testdata/geeklist_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request path
+		if r.URL.Path != "/geeklist/300001" {
+			t.Errorf("expected path '/geeklist/300001', got '%s'", r.URL.Path)
+		}
+
+		// Verify query parameters
+		comments := r.URL.Query().Get("comments")
+		if comments != "1" {
+			t.Errorf("expected comments '1', got '%s'", comments)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	geekList, err := client.GetGeekList(300001, true)
+	if err != nil {
+		t.Fatalf("GetGeekList failed: %v", err)
+	}
+
+	// Verify list fields
+	if geekList.ID != 300001 {
+		t.Errorf("expected ID 300001, got %d", geekList.ID)
+	}
+	if geekList.Title != "Best 2-player games" {
+		t.Errorf("expected Title 'Best 2-player games', got '%s'", geekList.Title)
+	}
+	if geekList.Username != "testuser" {
+		t.Errorf("expected Username 'testuser', got '%s'", geekList.Username)
+	}
+	if geekList.Description != "Our team's favourites for two.\nVote with thumbs!" {
+		t.Errorf("unexpected Description: '%s'", geekList.Description)
+	}
+	if geekList.PostDate != "Sat, 01 Jun 2024 09:00:00 +0000" {
+		t.Errorf("unexpected PostDate: '%s'", geekList.PostDate)
+	}
+	if geekList.EditDate != "Mon, 03 Jun 2024 18:20:00 +0000" {
+		t.Errorf("unexpected EditDate: '%s'", geekList.EditDate)
+	}
+	if geekList.Thumbs != 42 {
+		t.Errorf("expected Thumbs 42, got %d", geekList.Thumbs)
+	}
+	if geekList.NumItems != 2 {
+		t.Errorf("expected NumItems 2, got %d", geekList.NumItems)
+	}
+
+	// Verify list comments
+	if len(geekList.Comments) != 1 {
+		t.Fatalf("expected 1 list comment, got %d", len(geekList.Comments))
+	}
+	if geekList.Comments[0].Username != "friend1" {
+		t.Errorf("expected comment Username 'friend1', got '%s'", geekList.Comments[0].Username)
+	}
+	if geekList.Comments[0].Body != "Great list & thanks!" {
+		t.Errorf("unexpected comment Body: '%s'", geekList.Comments[0].Body)
+	}
+	if geekList.Comments[0].Thumbs != 3 {
+		t.Errorf("expected comment Thumbs 3, got %d", geekList.Comments[0].Thumbs)
+	}
+
+	// Verify items
+	if len(geekList.Items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(geekList.Items))
+	}
+
+	item := geekList.Items[0]
+	if item.ID != 9000001 {
+		t.Errorf("expected ID 9000001, got %d", item.ID)
+	}
+	if item.ObjectID != 224517 {
+		t.Errorf("expected ObjectID 224517, got %d", item.ObjectID)
+	}
+	if item.ObjectType != "thing" {
+		t.Errorf("expected ObjectType 'thing', got '%s'", item.ObjectType)
+	}
+	if item.Subtype != "boardgame" {
+		t.Errorf("expected Subtype 'boardgame', got '%s'", item.Subtype)
+	}
+	if item.ObjectName != "Brass: Birmingham" {
+		t.Errorf("expected ObjectName 'Brass: Birmingham', got '%s'", item.ObjectName)
+	}
+	if item.Username != "testuser" {
+		t.Errorf("expected Username 'testuser', got '%s'", item.Username)
+	}
+	if item.Thumbs != 12 {
+		t.Errorf("expected Thumbs 12, got %d", item.Thumbs)
+	}
+	if item.ImageID != 3490053 {
+		t.Errorf("expected ImageID 3490053, got %d", item.ImageID)
+	}
+	if item.PostDate != "Sat, 01 Jun 2024 09:05:00 +0000" {
+		t.Errorf("unexpected PostDate: '%s'", item.PostDate)
+	}
+
+	// Verify HTML entities are decoded in the body
+	if strings.Contains(item.Body, "&lt;") || strings.Contains(item.Body, "&#10;") {
+		t.Errorf("expected decoded Body, got '%s'", item.Body)
+	}
+	if item.Body != "Plays <b>great</b> with two.\nUse the 2p map." {
+		t.Errorf("unexpected Body: '%s'", item.Body)
+	}
+
+	if len(item.Comments) != 1 || item.Comments[0].Body != "Agreed." {
+		t.Errorf("unexpected item comments: %+v", item.Comments)
+	}
+	if len(geekList.Items[1].Comments) != 0 {
+		t.Errorf("expected no comments on second item, got %d", len(geekList.Items[1].Comments))
+	}
+}
+
+func TestGetGeekList_WithoutComments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("comments") {
+			t.Error("expected no comments parameter")
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<geeklist id="300001"><title>Empty</title><numitems>0</numitems></geeklist>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	geekList, err := client.GetGeekList(300001, false)
+	if err != nil {
+		t.Fatalf("GetGeekList failed: %v", err)
+	}
+	if len(geekList.Items) != 0 {
+		t.Errorf("expected 0 items, got %d", len(geekList.Items))
+	}
+}
+
+func TestGetGeekList_InvalidID(t *testing.T) {
+	client, _ := NewClient(Config{Token: "test-token"})
+
+	_, err := client.GetGeekList(0, false)
+	if err == nil {
+		t.Error("expected error for invalid ID")
+	}
+
+	var notFoundErr *NotFoundError
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected NotFoundError, got %T", err)
+	}
+}
+
+func TestGetGeekListJSON(t *testing.T) {
+	testData, err := os.ReadFile("testdata/geeklist_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	jsonStr, err := client.GetGeekListJSON(300001, false)
+	if err != nil {
+		t.Fatalf("GetGeekListJSON failed: %v", err)
+	}
+
+	// Verify it's valid JSON
+	var geekList GeekList
+	if err := json.Unmarshal([]byte(jsonStr), &geekList); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+
+	if len(geekList.Items) != 2 {
+		t.Errorf("expected 2 items, got %d", len(geekList.Items))
+	}
+}
@@ -0,0 +1,105 @@
+package bgg
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	// guildMembersPerPage is the number of members the Guild API returns per page.
+	guildMembersPerPage = 25
+)
+
+// GetGuild retrieves a guild's details.
+// If members is true, the given page of the member list is included.
+// Page is 1-indexed. Each page returns up to 25 members.
+func (c *Client) GetGuild(id int, members bool, page int) (*Guild, error) {
+	return c.GetGuildContext(context.Background(), id, members, page)
+}
+
+// GetGuildContext is like GetGuild but uses ctx for the request.
+func (c *Client) GetGuildContext(ctx context.Context, id int, members bool, page int) (*Guild, error) {
+	if id <= 0 {
+		return nil, newNotFoundError(id)
+	}
+	if page <= 0 {
+		page = 1
+	}
+
+	endpoint := fmt.Sprintf("/guild?id=%d", id)
+	if members {
+		endpoint += fmt.Sprintf("&members=1&page=%d", page)
+	}
+
+	body, err := c.doRequest(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	xmlResp, err := parseXML[xmlGuild](body, "failed to parse guild response")
+	if err != nil {
+		return nil, err
+	}
+
+	// BGG answers unknown guilds with <error>Guild not found.</error>
+	if xmlResp.Error != "" || xmlResp.ID == 0 {
+		return nil, newNotFoundError(id)
+	}
+
+	guild := &Guild{
+		ID:          xmlResp.ID,
+		Name:        xmlResp.Name,
+		Created:     xmlResp.Created,
+		CreatedTime: parseTime(xmlResp.Created),
+		Category:    xmlResp.Category,
+		Website:     xmlResp.Website,
+		Manager:     xmlResp.Manager,
+		Description: decodeHTML(strings.TrimSpace(xmlResp.Description)),
+		Location: GuildLocation{
+			Addr1:           xmlResp.Location.Addr1,
+			Addr2:           xmlResp.Location.Addr2,
+			City:            xmlResp.Location.City,
+			StateOrProvince: xmlResp.Location.StateOrProvince,
+			PostalCode:      xmlResp.Location.PostalCode,
+			Country:         xmlResp.Location.Country,
+		},
+	}
+
+	if xmlResp.Members != nil {
+		guild.MemberCount = xmlResp.Members.Count
+		guild.Page = xmlResp.Members.Page
+		if guild.Page == 0 {
+			guild.Page = page
+		}
+		for _, m := range xmlResp.Members.Members {
+			guild.Members = append(guild.Members, GuildMember{
+				Name:       m.Name,
+				JoinedDate: m.Date,
+				JoinedTime: parseTime(m.Date),
+			})
+		}
+
+		// Calculate total pages (25 members per page)
+		guild.TotalPages = (guild.MemberCount + guildMembersPerPage - 1) / guildMembersPerPage
+		if guild.TotalPages == 0 {
+			guild.TotalPages = 1
+		}
+	}
+
+	return guild, nil
+}
+
+// GetGuildJSON retrieves a guild's details and returns JSON.
+func (c *Client) GetGuildJSON(id int, members bool, page int) (string, error) {
+	return c.GetGuildJSONContext(context.Background(), id, members, page)
+}
+
+// GetGuildJSONContext is like GetGuildJSON but uses ctx for the request.
+func (c *Client) GetGuildJSONContext(ctx context.Context, id int, members bool, page int) (string, error) {
+	guild, err := c.GetGuildContext(ctx, id, members, page)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(guild)
+}
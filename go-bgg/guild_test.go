@@ -0,0 +1,170 @@
+package bgg
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestGetGuild(t *testing.T) {
+	testData, err := os.ReadFile("testdata/guild_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request path
+		if r.URL.Path != "/guild" {
+			t.Errorf("expected path '/guild', got '%s'", r.URL.Path)
+		}
+
+		// Verify query parameters
+		q := r.URL.Query()
+		if q.Get("id") != "1303" {
+			t.Errorf("expected id '1303', got '%s'", q.Get("id"))
+		}
+		if q.Get("members") != "1" {
+			t.Errorf("expected members '1', got '%s'", q.Get("members"))
+		}
+		if q.Get("page") != "2" {
+			t.Errorf("expected page '2', got '%s'", q.Get("page"))
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	guild, err := client.GetGuild(1303, true, 2)
+	if err != nil {
+		t.Fatalf("GetGuild failed: %v", err)
+	}
+
+	if guild.ID != 1303 {
+		t.Errorf("expected ID 1303, got %d", guild.ID)
+	}
+	if guild.Name != "Tokyo Board Gamers" {
+		t.Errorf("expected Name 'Tokyo Board Gamers', got '%s'", guild.Name)
+	}
+	if guild.Category != "group" {
+		t.Errorf("expected Category 'group', got '%s'", guild.Category)
+	}
+	if guild.Manager != "testuser" {
+		t.Errorf("expected Manager 'testuser', got '%s'", guild.Manager)
+	}
+	if guild.Description != "Weekly game nights in central Tokyo.\nAll welcome!" {
+		t.Errorf("unexpected Description: %q", guild.Description)
+	}
+	if guild.Location.City != "Tokyo" || guild.Location.Country != "Japan" {
+		t.Errorf("unexpected Location: %+v", guild.Location)
+	}
+
+	// Verify members
+	if guild.MemberCount != 52 {
+		t.Errorf("expected MemberCount 52, got %d", guild.MemberCount)
+	}
+	if guild.Page != 2 {
+		t.Errorf("expected Page 2, got %d", guild.Page)
+	}
+	if guild.TotalPages != 3 {
+		t.Errorf("expected TotalPages 3, got %d", guild.TotalPages)
+	}
+	if len(guild.Members) != 2 {
+		t.Fatalf("expected 2 members, got %d", len(guild.Members))
+	}
+	if guild.Members[0].Name != "friend1" {
+		t.Errorf("expected first member 'friend1', got '%s'", guild.Members[0].Name)
+	}
+	if guild.Members[0].JoinedDate != "Mon, 01 Jan 2018 00:00:00 +0000" {
+		t.Errorf("unexpected JoinedDate: '%s'", guild.Members[0].JoinedDate)
+	}
+	if want := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC); !guild.Members[0].JoinedTime.Equal(want) {
+		t.Errorf("expected JoinedTime %v, got %v", want, guild.Members[0].JoinedTime)
+	}
+	if want := time.Date(2007, 8, 5, 1, 4, 8, 0, time.UTC); !guild.CreatedTime.Equal(want) {
+		t.Errorf("expected CreatedTime %v, got %v", want, guild.CreatedTime)
+	}
+}
+
+func TestGetGuild_WithoutMembers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("members") {
+			t.Error("expected no members parameter")
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<guild id="1303" name="Tokyo Board Gamers"><category>group</category></guild>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	guild, err := client.GetGuild(1303, false, 0)
+	if err != nil {
+		t.Fatalf("GetGuild failed: %v", err)
+	}
+	if len(guild.Members) != 0 || guild.TotalPages != 0 {
+		t.Errorf("expected no member info, got %d members, %d pages", len(guild.Members), guild.TotalPages)
+	}
+}
+
+func TestGetGuild_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<guild id="0"><error>Guild not found.</error></guild>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	_, err := client.GetGuild(999999, false, 0)
+	var notFoundErr *NotFoundError
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected NotFoundError, got %T", err)
+	}
+}
+
+func TestGetGuild_InvalidID(t *testing.T) {
+	client, _ := NewClient(Config{Token: "test-token"})
+
+	_, err := client.GetGuild(0, false, 0)
+	var notFoundErr *NotFoundError
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected NotFoundError, got %T", err)
+	}
+}
+
+func TestGetGuildJSON(t *testing.T) {
+	testData, err := os.ReadFile("testdata/guild_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	jsonStr, err := client.GetGuildJSON(1303, true, 1)
+	if err != nil {
+		t.Fatalf("GetGuildJSON failed: %v", err)
+	}
+
+	// Verify it's valid JSON
+	var guild Guild
+	if err := json.Unmarshal([]byte(jsonStr), &guild); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+
+	if guild.ID != 1303 {
+		t.Errorf("expected ID 1303, got %d", guild.ID)
+	}
+}
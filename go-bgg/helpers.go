@@ -1,6 +1,7 @@
 package bgg
 
 import (
+	"bytes"
 	"encoding/json"
 	"encoding/xml"
 	"html"
@@ -33,6 +34,31 @@ func extractBoardGameRank(ranks []xmlRank) int {
 	return 0
 }
 
+// convertXMLToRanks converts XML ranks to Rank structs.
+// "Not Ranked" values are reported as 0.
+func convertXMLToRanks(ranks []xmlRank) []Rank {
+	var result []Rank
+	for _, r := range ranks {
+		rank := Rank{
+			Domain:       r.Name,
+			FriendlyName: r.FriendlyName,
+		}
+		rank.Value, _ = strconv.Atoi(r.Value)
+		rank.Bayes, _ = strconv.ParseFloat(r.BayesAverage, 64)
+		result = append(result, rank)
+	}
+	return result
+}
+
+// joinThingTypes joins thing types into a comma-separated list.
+func joinThingTypes(types []ThingType) string {
+	strs := make([]string, len(types))
+	for i, t := range types {
+		strs[i] = string(t)
+	}
+	return strings.Join(strs, ",")
+}
+
 // decodeHTML decodes HTML entities and replaces &#10; with newlines.
 func decodeHTML(s string) string {
 	decoded := html.UnescapeString(s)
@@ -48,3 +74,50 @@ func parseXML[T any](body []byte, errMsg string) (*T, error) {
 	}
 	return &result, nil
 }
+
+// parseErrorDocument returns the error described by body if it is one of
+// the documents BGG sends instead of data: <errors><error><message>,
+// <error message="..."/> or an "accepted, try again later" <message>.
+// It returns nil for regular responses.
+func parseErrorDocument(body []byte) error {
+	dec := xml.NewDecoder(bytes.NewReader(body))
+	var root xml.StartElement
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil
+		}
+		if se, ok := tok.(xml.StartElement); ok {
+			root = se
+			break
+		}
+	}
+
+	switch root.Name.Local {
+	case "message":
+		var text string
+		if err := dec.DecodeElement(&text, &root); err != nil {
+			return nil
+		}
+		return newProcessingError(strings.TrimSpace(text))
+
+	case "errors", "error":
+		var doc struct {
+			Attr     string   `xml:"message,attr"`
+			Message  string   `xml:"message"`
+			Messages []string `xml:"error>message"`
+		}
+		if err := dec.DecodeElement(&doc, &root); err != nil {
+			return nil
+		}
+		message := doc.Message
+		if len(doc.Messages) > 0 {
+			message = doc.Messages[0]
+		}
+		if message == "" {
+			message = doc.Attr
+		}
+		return newAPIError(strings.TrimSpace(message))
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package bgg
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -47,6 +48,26 @@ func TestExtractBoardGameRank(t *testing.T) {
 	}
 }
 
+func TestConvertXMLToRanks(t *testing.T) {
+	ranks := convertXMLToRanks([]xmlRank{
+		{Name: "boardgame", FriendlyName: "Board Game Rank", Value: "42", BayesAverage: "7.5"},
+		{Name: "partygames", FriendlyName: "Party Game Rank", Value: "Not Ranked", BayesAverage: "Not Ranked"},
+	})
+
+	if len(ranks) != 2 {
+		t.Fatalf("expected 2 ranks, got %d", len(ranks))
+	}
+	if ranks[0] != (Rank{Domain: "boardgame", FriendlyName: "Board Game Rank", Value: 42, Bayes: 7.5}) {
+		t.Errorf("unexpected first rank: %+v", ranks[0])
+	}
+	if ranks[1].Value != 0 || ranks[1].Bayes != 0 {
+		t.Errorf("expected Not Ranked to be 0, got %+v", ranks[1])
+	}
+	if convertXMLToRanks(nil) != nil {
+		t.Error("expected nil for no ranks")
+	}
+}
+
 func TestDecodeHTML(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -116,3 +137,78 @@ func TestParseXML(t *testing.T) {
 		}
 	})
 }
+
+func TestParseErrorDocument(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    string // error type, empty for none
+		message string
+	}{
+		{
+			name:    "invalid username",
+			body:    `<?xml version="1.0" encoding="utf-8" standalone="yes"?><errors><error><message>Invalid username specified</message></error></errors>`,
+			want:    "*bgg.InvalidUsernameError",
+			message: "Invalid username specified",
+		},
+		{
+			name:    "other error",
+			body:    `<errors><error><message>Invalid collection status</message></error></errors>`,
+			want:    "*bgg.APIError",
+			message: "Invalid collection status",
+		},
+		{
+			name:    "error with child message",
+			body:    `<error><message>Rate limit exceeded.</message></error>`,
+			want:    "*bgg.APIError",
+			message: "Rate limit exceeded.",
+		},
+		{
+			name:    "error with message attribute",
+			body:    `<error message="Item not found"/>`,
+			want:    "*bgg.APIError",
+			message: "Item not found",
+		},
+		{
+			name:    "accepted message",
+			body:    "<message>\n\tYour request for this collection has been accepted and will be processed.  Please try again later for access.\n</message>",
+			want:    "*bgg.ProcessingError",
+			message: "Your request for this collection has been accepted and will be processed.  Please try again later for access.",
+		},
+		{
+			name: "regular response",
+			body: `<items totalitems="0"></items>`,
+		},
+		{
+			name: "not XML",
+			body: ``,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := parseErrorDocument([]byte(tt.body))
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("parseErrorDocument() = %v, want nil", err)
+				}
+				return
+			}
+			if got := fmt.Sprintf("%T", err); got != tt.want {
+				t.Fatalf("parseErrorDocument() type = %s, want %s", got, tt.want)
+			}
+			var message string
+			switch e := err.(type) {
+			case *InvalidUsernameError:
+				message = e.Message
+			case *APIError:
+				message = e.Message
+			case *ProcessingError:
+				message = e.Message
+			}
+			if message != tt.message {
+				t.Errorf("message = %q, want %q", message, tt.message)
+			}
+		})
+	}
+}
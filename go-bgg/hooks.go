@@ -0,0 +1,75 @@
+package bgg
+
+import (
+	"context"
+	"time"
+)
+
+// RequestEvent describes a single HTTP attempt made by the client.
+type RequestEvent struct {
+	Endpoint   string        // Path and query, e.g. "/thing?id=13&stats=1"
+	URL        string        // Full request URL
+	Attempt    int           // 1-based attempt number
+	StatusCode int           // HTTP status (0 if no response was received)
+	Latency    time.Duration // Time from sending the request to reading the body
+	Bytes      int           // Size of the response body
+	Delay      time.Duration // OnRetry only: wait before the next attempt
+	Err        error         // OnRetry and OnError only: why the attempt failed
+}
+
+// Hooks observes the requests made by a Client. Methods are called
+// synchronously while the request runs, so they should return quickly and be
+// safe for concurrent use. Cache hits do not trigger any hook.
+//
+// Identical concurrent requests share one HTTP call (see the package
+// README), and so one set of events: they are reported once, from a
+// goroutine of their own, with the context of the caller that started the
+// call. Values such as trace IDs from the other callers' contexts are not
+// seen by the hooks.
+type Hooks interface {
+	// OnRequest is called before each attempt is sent.
+	OnRequest(ctx context.Context, ev RequestEvent)
+	// OnResponse is called for every response received, whatever its status.
+	OnResponse(ctx context.Context, ev RequestEvent)
+	// OnRetry is called before waiting to retry a failed attempt
+	// (e.g. 202, 429, 503 or a network error).
+	OnRetry(ctx context.Context, ev RequestEvent)
+	// OnError is called once when a request finally fails.
+	OnError(ctx context.Context, ev RequestEvent)
+}
+
+// HookFuncs implements Hooks with optional functions; nil fields are skipped.
+type HookFuncs struct {
+	Request  func(ctx context.Context, ev RequestEvent)
+	Response func(ctx context.Context, ev RequestEvent)
+	Retry    func(ctx context.Context, ev RequestEvent)
+	Error    func(ctx context.Context, ev RequestEvent)
+}
+
+// OnRequest calls h.Request if set.
+func (h HookFuncs) OnRequest(ctx context.Context, ev RequestEvent) {
+	if h.Request != nil {
+		h.Request(ctx, ev)
+	}
+}
+
+// OnResponse calls h.Response if set.
+func (h HookFuncs) OnResponse(ctx context.Context, ev RequestEvent) {
+	if h.Response != nil {
+		h.Response(ctx, ev)
+	}
+}
+
+// OnRetry calls h.Retry if set.
+func (h HookFuncs) OnRetry(ctx context.Context, ev RequestEvent) {
+	if h.Retry != nil {
+		h.Retry(ctx, ev)
+	}
+}
+
+// OnError calls h.Error if set.
+func (h HookFuncs) OnError(ctx context.Context, ev RequestEvent) {
+	if h.Error != nil {
+		h.Error(ctx, ev)
+	}
+}
@@ -0,0 +1,126 @@
+package bgg
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// recordingHooks collects the kind and event of each hook call.
+type recordingHooks struct {
+	mu     sync.Mutex
+	calls  []string
+	events []RequestEvent
+}
+
+func (h *recordingHooks) record(kind string) func(context.Context, RequestEvent) {
+	return func(_ context.Context, ev RequestEvent) {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.calls = append(h.calls, kind)
+		h.events = append(h.events, ev)
+	}
+}
+
+func (h *recordingHooks) hooks() HookFuncs {
+	return HookFuncs{
+		Request:  h.record("request"),
+		Response: h.record("response"),
+		Retry:    h.record("retry"),
+		Error:    h.record("error"),
+	}
+}
+
+func TestHooks_RetryThenSuccess(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("<ok/>"))
+	}))
+	defer server.Close()
+
+	rec := &recordingHooks{}
+	client := createTestClient(t, server)
+	client.retryDelay = 10 * time.Millisecond
+	client.hooks = rec.hooks()
+
+	if _, err := client.doRequestWithRetryOn202(context.Background(), "/collection?username=a", 3); err != nil {
+		t.Fatalf("doRequest() error = %v", err)
+	}
+
+	want := []string{"request", "response", "retry", "request", "response"}
+	if len(rec.calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", rec.calls, want)
+	}
+	for i := range want {
+		if rec.calls[i] != want[i] {
+			t.Errorf("calls[%d] = %q, want %q", i, rec.calls[i], want[i])
+		}
+	}
+
+	first := rec.events[1]
+	if first.Endpoint != "/collection?username=a" || first.Attempt != 1 || first.StatusCode != http.StatusAccepted {
+		t.Errorf("unexpected first response event: %+v", first)
+	}
+	retry := rec.events[2]
+	if retry.Delay != 10*time.Millisecond || retry.Err == nil {
+		t.Errorf("unexpected retry event: %+v", retry)
+	}
+	last := rec.events[4]
+	if last.Attempt != 2 || last.StatusCode != http.StatusOK || last.Bytes != len("<ok/>") || last.Latency <= 0 {
+		t.Errorf("unexpected last response event: %+v", last)
+	}
+}
+
+func TestHooks_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	rec := &recordingHooks{}
+	client := createTestClient(t, server)
+	client.hooks = rec.hooks()
+
+	_, err := client.GetHotGames()
+	if err == nil {
+		t.Fatal("expected error for unauthorized request")
+	}
+
+	if len(rec.calls) != 3 || rec.calls[2] != "error" {
+		t.Fatalf("calls = %v, want [request response error]", rec.calls)
+	}
+	ev := rec.events[2]
+	if ev.StatusCode != http.StatusUnauthorized || !errors.Is(ev.Err, err) {
+		t.Errorf("unexpected error event: %+v", ev)
+	}
+}
+
+func TestHooks_CacheHitSkipsHooks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("<items></items>"))
+	}))
+	defer server.Close()
+
+	rec := &recordingHooks{}
+	client := createTestClient(t, server)
+	client.cache = NewMemoryCache()
+	client.hooks = rec.hooks()
+
+	client.GetHotGames()
+	client.GetHotGames()
+
+	if len(rec.calls) != 2 {
+		t.Errorf("calls = %v, want only the first request and response", rec.calls)
+	}
+}
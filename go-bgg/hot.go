@@ -1,10 +1,49 @@
 package bgg
 
+import (
+	"context"
+	"fmt"
+)
+
 // GetHotGames retrieves the current hot games list.
 func (c *Client) GetHotGames() ([]HotGame, error) {
-	endpoint := "/hot?type=boardgame"
+	return c.GetHotGamesContext(context.Background())
+}
+
+// GetHotGamesContext is like GetHotGames but uses ctx for the request.
+func (c *Client) GetHotGamesContext(ctx context.Context) ([]HotGame, error) {
+	return c.GetHotContext(ctx, HotBoardGame)
+}
+
+// GetHotGamesJSON retrieves the current hot games list and returns JSON.
+func (c *Client) GetHotGamesJSON() (string, error) {
+	return c.GetHotGamesJSONContext(context.Background())
+}
 
-	body, err := c.doRequest(endpoint)
+// GetHotGamesJSONContext is like GetHotGamesJSON but uses ctx for the request.
+func (c *Client) GetHotGamesJSONContext(ctx context.Context) (string, error) {
+	games, err := c.GetHotGamesContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(games)
+}
+
+// GetHot retrieves the current hot list of the given type.
+// Person and company lists have no Year.
+func (c *Client) GetHot(hotType HotType) ([]HotGame, error) {
+	return c.GetHotContext(context.Background(), hotType)
+}
+
+// GetHotContext is like GetHot but uses ctx for the request.
+func (c *Client) GetHotContext(ctx context.Context, hotType HotType) ([]HotGame, error) {
+	if hotType == "" {
+		hotType = HotBoardGame
+	}
+
+	endpoint := fmt.Sprintf("/hot?type=%s", hotType)
+
+	body, err := c.doRequest(ctx, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -28,9 +67,14 @@ func (c *Client) GetHotGames() ([]HotGame, error) {
 	return games, nil
 }
 
-// GetHotGamesJSON retrieves the current hot games list and returns JSON.
-func (c *Client) GetHotGamesJSON() (string, error) {
-	games, err := c.GetHotGames()
+// GetHotJSON retrieves the current hot list of the given type and returns JSON.
+func (c *Client) GetHotJSON(hotType HotType) (string, error) {
+	return c.GetHotJSONContext(context.Background(), hotType)
+}
+
+// GetHotJSONContext is like GetHotJSON but uses ctx for the request.
+func (c *Client) GetHotJSONContext(ctx context.Context, hotType HotType) (string, error) {
+	games, err := c.GetHotContext(ctx, hotType)
 	if err != nil {
 		return "", err
 	}
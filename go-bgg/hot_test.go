@@ -68,6 +68,49 @@ func TestGetHotGames(t *testing.T) {
 	}
 }
 
+func TestGetHot_Persons(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		typeParam := r.URL.Query().Get("type")
+		if typeParam != "boardgameperson" {
+			t.Errorf("expected type 'boardgameperson', got '%s'", typeParam)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<items><item id="11" rank="1"><thumbnail value="https://example.com/klaus.jpg"/><name value="Klaus Teuber"/></item></items>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	persons, err := client.GetHot(HotBoardGamePerson)
+	if err != nil {
+		t.Fatalf("GetHot failed: %v", err)
+	}
+
+	if len(persons) != 1 {
+		t.Fatalf("expected 1 person, got %d", len(persons))
+	}
+	if persons[0].ID != 11 || persons[0].Name != "Klaus Teuber" || persons[0].Year != "" {
+		t.Errorf("unexpected person: %+v", persons[0])
+	}
+}
+
+func TestGetHot_DefaultType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if typeParam := r.URL.Query().Get("type"); typeParam != "boardgame" {
+			t.Errorf("expected type 'boardgame', got '%s'", typeParam)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<items></items>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	if _, err := client.GetHot(""); err != nil {
+		t.Fatalf("GetHot failed: %v", err)
+	}
+}
+
 func TestGetHotGamesJSON(t *testing.T) {
 	testData, err := os.ReadFile("testdata/hot_response.xml")
 	if err != nil {
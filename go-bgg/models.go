@@ -1,12 +1,62 @@
 // Package bgg provides a client for the BoardGameGeek XML API.
 package bgg
 
+import "time"
+
 // GameSearchResult represents a game in search results.
 type GameSearchResult struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
 	Year string `json:"year"`
-	Type string `json:"type"` // "boardgame" or "boardgameexpansion"
+	Type string `json:"type"` // e.g. "boardgame", "boardgameexpansion", "rpgitem"
+}
+
+// ThingType identifies the kind of item served by the thing and search endpoints.
+type ThingType string
+
+// Thing types across BoardGameGeek, RPGGeek and VideoGameGeek.
+const (
+	ThingBoardGame          ThingType = "boardgame"
+	ThingBoardGameExpansion ThingType = "boardgameexpansion"
+	ThingBoardGameAccessory ThingType = "boardgameaccessory"
+	ThingRPGItem            ThingType = "rpgitem"
+	ThingRPGIssue           ThingType = "rpgissue"
+	ThingVideoGame          ThingType = "videogame"
+)
+
+// SearchOptions specifies options for searching items.
+type SearchOptions struct {
+	Types []ThingType // Item types to search (default: all types)
+	Exact bool        // Only return exact name matches
+}
+
+// HotType identifies which hot list to fetch.
+type HotType string
+
+// Hot list types.
+const (
+	HotBoardGame        HotType = "boardgame"
+	HotRPG              HotType = "rpg"
+	HotVideoGame        HotType = "videogame"
+	HotBoardGamePerson  HotType = "boardgameperson"
+	HotRPGPerson        HotType = "rpgperson"
+	HotBoardGameCompany HotType = "boardgamecompany"
+	HotRPGCompany       HotType = "rpgcompany"
+	HotVideoGameCompany HotType = "videogamecompany"
+)
+
+// Thing represents the fields shared by every item type
+// (board games, RPG items, video games, ...).
+type Thing struct {
+	ID             int       `json:"id"`
+	Type           ThingType `json:"type"`
+	Name           string    `json:"name"`
+	AlternateNames []string  `json:"alternate_names"`
+	Year           string    `json:"year"`
+	Description    string    `json:"description"`
+	Thumbnail      string    `json:"thumbnail"`
+	Image          string    `json:"image"`
+	Links          []Link    `json:"links"` // All links, in document order
 }
 
 // PlayerCountPoll represents poll data for suggested number of players.
@@ -25,14 +75,45 @@ type PlayerCountVotes struct {
 	NotRecommended int    `json:"not_recommended"`
 }
 
+// AgePoll represents poll data for suggested player age.
+type AgePoll struct {
+	TotalVotes int        `json:"total_votes"`
+	Results    []PollVote `json:"results"`
+	Winner     string     `json:"winner"` // Age with the most votes (e.g. "10", "21 and up"), empty if no votes
+}
+
+// PollVote represents the votes for a single poll option.
+type PollVote struct {
+	Value    string `json:"value"`
+	NumVotes int    `json:"num_votes"`
+}
+
+// LanguageDependencePoll represents poll data for language dependence.
+type LanguageDependencePoll struct {
+	TotalVotes  int                       `json:"total_votes"`
+	Results     []LanguageDependenceVotes `json:"results"`
+	Winner      string                    `json:"winner"`       // Description of the level with the most votes, empty if no votes
+	WinnerLevel int                       `json:"winner_level"` // 1 (no in-game text) to 5 (unplayable in another language), 0 if no votes
+}
+
+// LanguageDependenceVotes represents votes for a language dependence level.
+type LanguageDependenceVotes struct {
+	Level       int    `json:"level"`
+	Description string `json:"description"`
+	NumVotes    int    `json:"num_votes"`
+}
+
+// PollSummary represents BGG's precomputed summary of a poll.
+type PollSummary struct {
+	Name    string            `json:"name"` // e.g. "suggested_numplayers"
+	Title   string            `json:"title"`
+	Results map[string]string `json:"results"` // e.g. "bestwith" -> "Best with 4 players"
+}
+
 // Game represents detailed information about a board game.
+// The common item fields come from the embedded Thing.
 type Game struct {
-	ID          int      `json:"id"`
-	Name        string   `json:"name"`
-	Year        string   `json:"year"`
-	Description string   `json:"description"`
-	Thumbnail   string   `json:"thumbnail"`
-	Image       string   `json:"image"`
+	Thing
 	MinPlayers  int      `json:"min_players"`
 	MaxPlayers  int      `json:"max_players"`
 	PlayingTime int      `json:"playing_time"`
@@ -54,7 +135,137 @@ type Game struct {
 	Publishers  []string `json:"publishers"`
 	Categories  []string `json:"categories"`
 	Mechanics       []string         `json:"mechanics"`
+	Families        []string         `json:"families"`
 	PlayerCountPoll *PlayerCountPoll `json:"player_count_poll,omitempty"`
+
+	// Remaining community polls and the summaries BGG computes for them
+	AgePoll                *AgePoll                `json:"age_poll,omitempty"`
+	LanguageDependencePoll *LanguageDependencePoll `json:"language_dependence_poll,omitempty"`
+	PollSummaries          []PollSummary           `json:"poll_summaries,omitempty"`
+
+	// All ranks (overall and subdomains)
+	Ranks []Rank `json:"ranks"`
+
+	// Typed links; the string fields above hold the same names for convenience
+	DesignerLinks   []Link `json:"designer_links"`
+	ArtistLinks     []Link `json:"artist_links"`
+	PublisherLinks  []Link `json:"publisher_links"`
+	CategoryLinks   []Link `json:"category_links"`
+	MechanicLinks   []Link `json:"mechanic_links"`
+	FamilyLinks     []Link `json:"family_links"`
+	Expansions      []Link `json:"expansions"`
+	Accessories     []Link `json:"accessories"`
+	Implementations []Link `json:"implementations"`
+	Integrations    []Link `json:"integrations"`
+	Compilations    []Link `json:"compilations"`
+
+	// Populated only when requested via GetGameWithOptions
+	Versions            []Version            `json:"versions,omitempty"`
+	Videos              []Video              `json:"videos,omitempty"`
+	MarketplaceListings []MarketplaceListing `json:"marketplace_listings,omitempty"`
+	Comments            *CommentList         `json:"comments,omitempty"`
+}
+
+// GameOptions specifies optional data to include when fetching a game.
+type GameOptions struct {
+	Versions       bool // Include versions (editions) of the game
+	Videos         bool // Include videos
+	Marketplace    bool // Include marketplace listings
+	Comments       bool // Include user comments
+	RatingComments bool // Include user ratings, with or without a comment
+	Page           int  // Comments page (default: 1)
+	PageSize       int  // Comments per page, 10-100 (default: 100)
+}
+
+// Version represents a published version (edition) of a game.
+type Version struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Year        string   `json:"year"`
+	Thumbnail   string   `json:"thumbnail"`
+	Image       string   `json:"image"`
+	ProductCode string   `json:"product_code"`
+	Width       float64  `json:"width"`  // inches
+	Length      float64  `json:"length"` // inches
+	Depth       float64  `json:"depth"`  // inches
+	Weight      float64  `json:"weight"` // pounds
+	Publishers  []string `json:"publishers"`
+	Artists     []string `json:"artists"`
+	Languages   []string `json:"languages"`
+}
+
+// Video represents a video linked to a game.
+type Video struct {
+	ID       int       `json:"id"`
+	Title    string    `json:"title"`
+	Category string    `json:"category"` // e.g. "review", "instructional", "session"
+	Language string    `json:"language"`
+	Link     string    `json:"link"`
+	Username string    `json:"username"`
+	UserID   int       `json:"user_id"`
+	PostDate string    `json:"post_date"`
+	PostTime time.Time `json:"post_time,omitzero"`
+}
+
+// MarketplaceListing represents a marketplace listing for a game.
+type MarketplaceListing struct {
+	ListDate  string    `json:"list_date"`
+	ListTime  time.Time `json:"list_time,omitzero"`
+	Price     float64   `json:"price"`
+	Currency  string    `json:"currency"`
+	Condition string    `json:"condition"` // e.g. "new", "likenew", "verygood"
+	Notes     string    `json:"notes"`
+	Link      string    `json:"link"`
+}
+
+// CommentList represents a page of user comments on a game.
+type CommentList struct {
+	Page       int       `json:"page"`
+	TotalItems int       `json:"total_items"`
+	TotalPages int       `json:"total_pages"`
+	Comments   []Comment `json:"comments"`
+}
+
+// Comment represents a user comment and/or rating on a game.
+type Comment struct {
+	Username string  `json:"username"`
+	Rating   float64 `json:"rating"` // 0 = Not Rated
+	Value    string  `json:"value"`
+}
+
+// Rank represents a game's rank in the overall board game list or in a
+// subdomain such as strategy or party games.
+type Rank struct {
+	Domain       string  `json:"domain"` // e.g. "boardgame", "strategygames", "partygames"
+	FriendlyName string  `json:"friendly_name"`
+	Value        int     `json:"value"` // 0 = Not Ranked
+	Bayes        float64 `json:"bayes"` // Bayes average within the domain, 0 if not ranked
+}
+
+// LinkType identifies the kind of entity a Link points to.
+type LinkType string
+
+// Link types used by the thing endpoint.
+const (
+	LinkDesigner       LinkType = "boardgamedesigner"
+	LinkArtist         LinkType = "boardgameartist"
+	LinkPublisher      LinkType = "boardgamepublisher"
+	LinkCategory       LinkType = "boardgamecategory"
+	LinkMechanic       LinkType = "boardgamemechanic"
+	LinkFamily         LinkType = "boardgamefamily"
+	LinkExpansion      LinkType = "boardgameexpansion"
+	LinkAccessory      LinkType = "boardgameaccessory"
+	LinkImplementation LinkType = "boardgameimplementation"
+	LinkIntegration    LinkType = "boardgameintegration"
+	LinkCompilation    LinkType = "boardgamecompilation"
+)
+
+// Link represents a reference from a game to another BGG entity.
+type Link struct {
+	ID      int      `json:"id"`
+	Type    LinkType `json:"type"`
+	Name    string   `json:"name"`
+	Inbound bool     `json:"inbound"` // true if the relation points back at this game (e.g. "expanded by" vs "expands")
 }
 
 // HotGame represents a game in the hot list.
@@ -76,6 +287,43 @@ type CollectionOptions struct {
 	WantToBuy  bool
 	Wishlist   bool
 	Preordered bool
+
+	// SplitExpansions fetches base games and expansions in two requests so
+	// that expansions report Subtype "boardgameexpansion"; in a single
+	// request BGG lists them as "boardgame". Expansions then follow the base
+	// games. Ignored if ExcludeSubtype or a Subtype other than boardgame is set.
+	SplitExpansions bool
+
+	// Additional filters; zero values are not sent
+	Subtype        ThingType // e.g. ThingBoardGameExpansion to list expansions only (default: boardgame, which includes expansions)
+	ExcludeSubtype ThingType // e.g. ThingBoardGameExpansion to list base games only
+	Brief          bool      // Return abbreviated results
+	Version        bool      // Include the owned version of each item
+	MinRating      float64   // User rating at least this (1-10)
+	Rating         float64   // User rating at most this (1-10)
+	MinBGGRating   float64   // BGG rating at least this (1-10)
+	MinPlays       int       // Recorded plays at least this
+	MaxPlays       int       // Recorded plays at most this
+	Played         bool      // Only items with recorded plays
+	Rated          bool      // Only items the user rated
+	Comment        bool      // Only items the user commented on
+	HasParts       bool      // Only items with a has-parts list
+	WantParts      bool      // Only items with a want-parts list
+	ModifiedSince  time.Time // Only items modified since this time
+
+	// Progress, if set, is called before each retry while BGG prepares the
+	// collection (202 Accepted). It runs on the requesting goroutine. With
+	// SplitExpansions, attempts and elapsed time add up over both requests.
+	Progress func(CollectionProgress)
+}
+
+// CollectionProgress describes a pending collection request.
+type CollectionProgress struct {
+	Attempt     int           // Attempts made so far
+	MaxAttempts int           // Total attempts allowed, 0 if unknown (custom RetryPolicy)
+	Elapsed     time.Duration // Time since the request started
+	RetryIn     time.Duration // Delay before the next attempt
+	Err         error         // Error of the last attempt, usually a *ProcessingError
 }
 
 // CollectionItem represents a game in a user's collection.
@@ -98,16 +346,31 @@ type CollectionItem struct {
 	WantToBuy  bool    `json:"want_to_buy"`
 	Wishlist   bool    `json:"wishlist"`
 	Preordered bool    `json:"preordered"`
+	Ranks      []Rank  `json:"ranks"`
+
+	CollID           int       `json:"coll_id"`
+	Subtype          string    `json:"subtype"` // "boardgameexpansion" for expansions, else as reported by BGG (e.g. "boardgame")
+	MinPlayers       int       `json:"min_players"`
+	MaxPlayers       int       `json:"max_players"`
+	MinPlayTime      int       `json:"min_play_time"`
+	MaxPlayTime      int       `json:"max_play_time"`
+	PlayingTime      int       `json:"playing_time"`
+	WishlistPriority int       `json:"wishlist_priority"` // 1 (must have) to 5 (don't buy), 0 if not on the wishlist
+	LastModified     string    `json:"last_modified"`
+	LastModifiedTime time.Time `json:"last_modified_time,omitzero"` // BGG gives no zone; parsed as UTC
+	Comment          string    `json:"comment"`
+	Version          *Version  `json:"version,omitempty"` // Populated when requested via CollectionOptions.Version
 }
 
 // Forum represents a forum category for a game.
 type Forum struct {
-	ID           int    `json:"id"`
-	Title        string `json:"title"`
-	Description  string `json:"description"`
-	NumThreads   int    `json:"num_threads"`
-	NumPosts     int    `json:"num_posts"`
-	LastPostDate string `json:"last_post_date"`
+	ID           int       `json:"id"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description"`
+	NumThreads   int       `json:"num_threads"`
+	NumPosts     int       `json:"num_posts"`
+	LastPostDate string    `json:"last_post_date"`
+	LastPostTime time.Time `json:"last_post_time,omitzero"`
 }
 
 // ThreadList represents a paginated list of threads.
@@ -119,27 +382,244 @@ type ThreadList struct {
 
 // ThreadSummary represents a thread in a forum listing.
 type ThreadSummary struct {
-	ID           int    `json:"id"`
-	Subject      string `json:"subject"`
-	Author       string `json:"author"`
-	NumArticles  int    `json:"num_articles"`
-	PostDate     string `json:"post_date"`
-	LastPostDate string `json:"last_post_date"`
+	ID           int       `json:"id"`
+	Subject      string    `json:"subject"`
+	Author       string    `json:"author"`
+	NumArticles  int       `json:"num_articles"`
+	PostDate     string    `json:"post_date"`
+	PostTime     time.Time `json:"post_time,omitzero"`
+	LastPostDate string    `json:"last_post_date"`
+	LastPostTime time.Time `json:"last_post_time,omitzero"`
 }
 
 // Thread represents a thread with its articles.
 type Thread struct {
-	ID         int       `json:"id"`
-	Subject    string    `json:"subject"`
-	Articles   []Article `json:"articles"`
-	Page       int       `json:"page"`
-	TotalPages int       `json:"total_pages"`
+	ID          int       `json:"id"`
+	Subject     string    `json:"subject"`
+	Articles    []Article `json:"articles"`
+	Page        int       `json:"page"`         // 1 for the first page, 0 when fetched from MinArticleID/MinArticleDate
+	TotalPages  int       `json:"total_pages"`  // NumArticles / page size, rounded up
+	NumArticles int       `json:"num_articles"` // Total articles in the thread
+	HasMore     bool      `json:"has_more"`     // More articles follow the last one returned
+}
+
+// ThreadOptions specifies which slice of a thread to fetch.
+type ThreadOptions struct {
+	MinArticleID   int       // Only articles with this ID or higher
+	MinArticleDate time.Time // Only articles posted at or after this time
+	Count          int       // Maximum number of articles to return (default: all, as far as BGG allows)
 }
 
 // Article represents a post in a thread.
 type Article struct {
-	ID       int    `json:"id"`
-	Username string `json:"username"`
-	PostDate string `json:"post_date"`
-	Body     string `json:"body"`
+	ID       int       `json:"id"`
+	Username string    `json:"username"`
+	PostDate string    `json:"post_date"`
+	PostTime time.Time `json:"post_time,omitzero"`
+	Body     string    `json:"body"`
+}
+
+// PlaysOptions specifies options for fetching logged plays.
+type PlaysOptions struct {
+	ID      int       // Optional: only plays of this thing
+	Subtype string    // Optional: thing subtype (e.g. "boardgame", "boardgameexpansion")
+	MinDate time.Time // Optional: only plays on or after this date
+	MaxDate time.Time // Optional: only plays on or before this date
+	Page    int       // Optional: 1-indexed page (default: 1)
+}
+
+// PlayList represents a paginated list of logged plays.
+type PlayList struct {
+	Username   string `json:"username"`
+	UserID     int    `json:"user_id"`
+	Total      int    `json:"total"`
+	Page       int    `json:"page"`
+	TotalPages int    `json:"total_pages"`
+	Plays      []Play `json:"plays"`
+}
+
+// Play represents a single logged play.
+type Play struct {
+	ID         int          `json:"id"`
+	Date       string       `json:"date"`          // "YYYY-MM-DD"
+	Time       time.Time    `json:"time,omitzero"` // Date at midnight UTC
+	Quantity   int          `json:"quantity"`
+	Length     int          `json:"length"` // minutes, 0 = not recorded
+	Incomplete bool         `json:"incomplete"`
+	NoWinStats bool         `json:"no_win_stats"`
+	Location   string       `json:"location"`
+	Comments   string       `json:"comments"`
+	GameID     int          `json:"game_id"`
+	GameName   string       `json:"game_name"`
+	ObjectType string       `json:"object_type"`
+	Subtypes   []string     `json:"subtypes"`
+	Players    []PlayPlayer `json:"players"`
+}
+
+// PlayPlayer represents a player in a logged play.
+type PlayPlayer struct {
+	Username      string  `json:"username"`
+	UserID        int     `json:"user_id"`
+	Name          string  `json:"name"`
+	StartPosition string  `json:"start_position"`
+	Color         string  `json:"color"`
+	Score         string  `json:"score"`
+	Rating        float64 `json:"rating"`
+	New           bool    `json:"new"`
+	Win           bool    `json:"win"`
+}
+
+// UserOptions specifies which optional sections to include when fetching a user.
+type UserOptions struct {
+	Buddies bool   // Include the user's buddies
+	Guilds  bool   // Include the user's guild memberships
+	Hot     bool   // Include the user's hot 10 list
+	Top     bool   // Include the user's top 10 list
+	Domain  string // Optional: domain for hot/top lists ("boardgame", "rpg", "videogame"; default: "boardgame")
+	Page    int    // Optional: 1-indexed page of buddies/guilds (100 per page)
+}
+
+// User represents a BGG user profile.
+type User struct {
+	ID              int             `json:"id"`
+	Name            string          `json:"name"`
+	FirstName       string          `json:"first_name"`
+	LastName        string          `json:"last_name"`
+	Avatar          string          `json:"avatar"` // empty if the user has no avatar
+	YearRegistered  int             `json:"year_registered"`
+	LastLogin       string          `json:"last_login"` // "YYYY-MM-DD"
+	LastLoginTime   time.Time       `json:"last_login_time,omitzero"`
+	StateOrProvince string          `json:"state_or_province"`
+	Country         string          `json:"country"`
+	WebAddress      string          `json:"web_address"`
+	TradeRating     int             `json:"trade_rating"`
+	Buddies         []UserBuddy     `json:"buddies,omitempty"`
+	TotalBuddies    int             `json:"total_buddies"`
+	Guilds          []UserGuild     `json:"guilds,omitempty"`
+	TotalGuilds     int             `json:"total_guilds"`
+	Page            int             `json:"page"`
+	Hot             *UserRankedList `json:"hot,omitempty"`
+	Top             *UserRankedList `json:"top,omitempty"`
+}
+
+// UserBuddy represents a buddy in a user's profile.
+type UserBuddy struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// UserGuild represents a guild membership in a user's profile.
+type UserGuild struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// UserRankedList represents a user's hot or top 10 list for a domain.
+type UserRankedList struct {
+	Domain string           `json:"domain"`
+	Items  []UserRankedItem `json:"items"`
+}
+
+// UserRankedItem represents an entry in a user's hot or top 10 list.
+type UserRankedItem struct {
+	Rank int    `json:"rank"`
+	ID   int    `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// Guild represents a BGG guild.
+type Guild struct {
+	ID          int           `json:"id"`
+	Name        string        `json:"name"`
+	Created     string        `json:"created"`
+	CreatedTime time.Time     `json:"created_time,omitzero"`
+	Category    string        `json:"category"`
+	Website     string        `json:"website"`
+	Manager     string        `json:"manager"`
+	Description string        `json:"description"`
+	Location    GuildLocation `json:"location"`
+	MemberCount int           `json:"member_count"`
+	Members     []GuildMember `json:"members,omitempty"`
+	Page        int           `json:"page"`
+	TotalPages  int           `json:"total_pages"`
+}
+
+// GuildLocation represents a guild's postal location.
+type GuildLocation struct {
+	Addr1           string `json:"addr1"`
+	Addr2           string `json:"addr2"`
+	City            string `json:"city"`
+	StateOrProvince string `json:"state_or_province"`
+	PostalCode      string `json:"postal_code"`
+	Country         string `json:"country"`
+}
+
+// GuildMember represents a member of a guild.
+type GuildMember struct {
+	Name       string    `json:"name"`
+	JoinedDate string    `json:"joined_date"`
+	JoinedTime time.Time `json:"joined_time,omitzero"`
+}
+
+// Family represents a family of related things (e.g. "Series: Catan").
+type Family struct {
+	ID          int            `json:"id"`
+	Type        string         `json:"type"` // e.g. "boardgamefamily"
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Thumbnail   string         `json:"thumbnail"`
+	Image       string         `json:"image"`
+	Members     []FamilyMember `json:"members"`
+}
+
+// FamilyMember represents a thing linked to a family.
+type FamilyMember struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// GeekList represents a user-curated list of things.
+type GeekList struct {
+	ID          int               `json:"id"`
+	Title       string            `json:"title"`
+	Username    string            `json:"username"`
+	Description string            `json:"description"`
+	PostDate    string            `json:"post_date"`
+	EditDate    string            `json:"edit_date"`
+	PostTime    time.Time         `json:"post_time,omitzero"`
+	EditTime    time.Time         `json:"edit_time,omitzero"`
+	Thumbs      int               `json:"thumbs"`
+	NumItems    int               `json:"num_items"`
+	Items       []GeekListItem    `json:"items"`
+	Comments    []GeekListComment `json:"comments,omitempty"`
+}
+
+// GeekListItem represents an entry in a GeekList.
+type GeekListItem struct {
+	ID         int               `json:"id"`
+	ObjectID   int               `json:"object_id"`
+	ObjectType string            `json:"object_type"` // e.g. "thing", "person", "family"
+	Subtype    string            `json:"subtype"`     // e.g. "boardgame"
+	ObjectName string            `json:"object_name"`
+	Username   string            `json:"username"`
+	Body       string            `json:"body"`
+	Thumbs     int               `json:"thumbs"`
+	ImageID    int               `json:"image_id"`
+	PostDate   string            `json:"post_date"`
+	EditDate   string            `json:"edit_date"`
+	PostTime   time.Time         `json:"post_time,omitzero"`
+	EditTime   time.Time         `json:"edit_time,omitzero"`
+	Comments   []GeekListComment `json:"comments,omitempty"`
+}
+
+// GeekListComment represents a comment on a GeekList or one of its items.
+type GeekListComment struct {
+	Username string    `json:"username"`
+	PostDate string    `json:"post_date"`
+	EditDate string    `json:"edit_date"`
+	PostTime time.Time `json:"post_time,omitzero"`
+	EditTime time.Time `json:"edit_time,omitzero"`
+	Thumbs   int       `json:"thumbs"`
+	Body     string    `json:"body"`
 }
@@ -60,13 +60,96 @@ type xmlThingItem struct {
 	Polls         []xmlPoll        `xml:"poll"`
 	PollSummaries []xmlPollSummary `xml:"poll-summary"`
 	Statistics    xmlStatistics    `xml:"statistics"`
+
+	Versions    *xmlVersions    `xml:"versions"`
+	Videos      *xmlVideos      `xml:"videos"`
+	Marketplace *xmlMarketplace `xml:"marketplacelistings"`
+	Comments    *xmlComments    `xml:"comments"`
+}
+
+// xmlVersions contains the versions of a thing.
+type xmlVersions struct {
+	Items []xmlVersion `xml:"item"`
+}
+
+// xmlVersion represents a version (edition) of a thing.
+type xmlVersion struct {
+	ID          int           `xml:"id,attr"`
+	Thumbnail   string        `xml:"thumbnail"`
+	Image       string        `xml:"image"`
+	Names       []xmlNameElem `xml:"name"`
+	Links       []xmlLink     `xml:"link"`
+	YearValue   xmlValue      `xml:"yearpublished"`
+	ProductCode xmlValue      `xml:"productcode"`
+	Width       xmlValue      `xml:"width"`
+	Length      xmlValue      `xml:"length"`
+	Depth       xmlValue      `xml:"depth"`
+	Weight      xmlValue      `xml:"weight"`
+}
+
+// xmlVideos contains the videos of a thing.
+type xmlVideos struct {
+	Total int        `xml:"total,attr"`
+	Items []xmlVideo `xml:"video"`
+}
+
+// xmlVideo represents a video linked to a thing.
+type xmlVideo struct {
+	ID       int    `xml:"id,attr"`
+	Title    string `xml:"title,attr"`
+	Category string `xml:"category,attr"`
+	Language string `xml:"language,attr"`
+	Link     string `xml:"link,attr"`
+	Username string `xml:"username,attr"`
+	UserID   int    `xml:"userid,attr"`
+	PostDate string `xml:"postdate,attr"`
+}
+
+// xmlMarketplace contains the marketplace listings of a thing.
+type xmlMarketplace struct {
+	Listings []xmlListing `xml:"listing"`
+}
+
+// xmlListing represents a marketplace listing.
+type xmlListing struct {
+	ListDate  xmlValue   `xml:"listdate"`
+	Price     xmlPrice   `xml:"price"`
+	Condition xmlValue   `xml:"condition"`
+	Notes     xmlValue   `xml:"notes"`
+	Link      xmlHrefRef `xml:"link"`
+}
+
+// xmlPrice represents a price with its currency.
+type xmlPrice struct {
+	Currency string `xml:"currency,attr"`
+	Value    string `xml:"value,attr"`
+}
+
+// xmlHrefRef represents an element with an href attribute.
+type xmlHrefRef struct {
+	Href string `xml:"href,attr"`
+}
+
+// xmlComments contains a page of comments on a thing.
+type xmlComments struct {
+	Page       int          `xml:"page,attr"`
+	TotalItems int          `xml:"totalitems,attr"`
+	Items      []xmlComment `xml:"comment"`
+}
+
+// xmlComment represents a user comment. Rating is "N/A" when not rated.
+type xmlComment struct {
+	Username string `xml:"username,attr"`
+	Rating   string `xml:"rating,attr"`
+	Value    string `xml:"value,attr"`
 }
 
 // xmlLink represents a link element (designer, category, mechanic, etc.).
 type xmlLink struct {
-	Type  string `xml:"type,attr"`
-	ID    int    `xml:"id,attr"`
-	Value string `xml:"value,attr"`
+	Type    string `xml:"type,attr"`
+	ID      int    `xml:"id,attr"`
+	Value   string `xml:"value,attr"`
+	Inbound string `xml:"inbound,attr"`
 }
 
 // xmlStatistics contains game statistics.
@@ -105,6 +188,7 @@ type xmlRank struct {
 	Name       string `xml:"name,attr"`
 	FriendlyName string `xml:"friendlyname,attr"`
 	Value      string `xml:"value,attr"`
+	BayesAverage string `xml:"bayesaverage,attr"`
 }
 
 // xmlPoll represents a poll element.
@@ -123,6 +207,7 @@ type xmlPollResults struct {
 
 // xmlPollResult represents a single result entry in a poll.
 type xmlPollResult struct {
+	Level    int    `xml:"level,attr"` // language_dependence only
 	Value    string `xml:"value,attr"`
 	NumVotes int    `xml:"numvotes,attr"`
 }
@@ -130,6 +215,7 @@ type xmlPollResult struct {
 // xmlPollSummary represents a poll-summary element.
 type xmlPollSummary struct {
 	Name    string                 `xml:"name,attr"`
+	Title   string                 `xml:"title,attr"`
 	Results []xmlPollSummaryResult `xml:"result"`
 }
 
@@ -173,6 +259,8 @@ type xmlCollectionItem struct {
 	Status     xmlCollectionStatus  `xml:"status"`
 	NumPlays   int                  `xml:"numplays"`
 	Stats      xmlCollectionStats   `xml:"stats"`
+	Comment    string               `xml:"comment"`
+	Version    *xmlVersions         `xml:"version"`
 }
 
 // xmlCollectionName represents the name element in collection.
@@ -192,6 +280,8 @@ type xmlCollectionStatus struct {
 	Wishlist     string `xml:"wishlist,attr"`
 	Preordered   string `xml:"preordered,attr"`
 	LastModified string `xml:"lastmodified,attr"`
+
+	WishlistPriority int `xml:"wishlistpriority,attr"`
 }
 
 // xmlCollectionStats contains collection item statistics.
@@ -281,3 +371,197 @@ type xmlArticle struct {
 	NumEdits int    `xml:"numedits,attr"`
 	Body     string `xml:"body"`
 }
+
+// xmlPlays is the root element for plays responses.
+type xmlPlays struct {
+	XMLName  xml.Name  `xml:"plays"`
+	Username string    `xml:"username,attr"`
+	UserID   int       `xml:"userid,attr"`
+	Total    int       `xml:"total,attr"`
+	Page     int       `xml:"page,attr"`
+	Plays    []xmlPlay `xml:"play"`
+}
+
+// xmlPlay represents a single logged play.
+type xmlPlay struct {
+	ID         int             `xml:"id,attr"`
+	Date       string          `xml:"date,attr"`
+	Quantity   int             `xml:"quantity,attr"`
+	Length     int             `xml:"length,attr"`
+	Incomplete int             `xml:"incomplete,attr"`
+	NoWinStats int             `xml:"nowinstats,attr"`
+	Location   string          `xml:"location,attr"`
+	Item       xmlPlayItem     `xml:"item"`
+	Comments   string          `xml:"comments"`
+	Players    []xmlPlayPlayer `xml:"players>player"`
+}
+
+// xmlPlayItem represents the thing a play was logged for.
+type xmlPlayItem struct {
+	Name       string     `xml:"name,attr"`
+	ObjectType string     `xml:"objecttype,attr"`
+	ObjectID   int        `xml:"objectid,attr"`
+	Subtypes   []xmlValue `xml:"subtypes>subtype"`
+}
+
+// xmlPlayPlayer represents a player in a logged play.
+type xmlPlayPlayer struct {
+	Username      string `xml:"username,attr"`
+	UserID        int    `xml:"userid,attr"`
+	Name          string `xml:"name,attr"`
+	StartPosition string `xml:"startposition,attr"`
+	Color         string `xml:"color,attr"`
+	Score         string `xml:"score,attr"`
+	New           int    `xml:"new,attr"`
+	Rating        string `xml:"rating,attr"`
+	Win           int    `xml:"win,attr"`
+}
+
+// xmlUser is the root element for user responses.
+type xmlUser struct {
+	XMLName         xml.Name       `xml:"user"`
+	ID              string         `xml:"id,attr"`
+	Name            string         `xml:"name,attr"`
+	FirstName       xmlValue       `xml:"firstname"`
+	LastName        xmlValue       `xml:"lastname"`
+	AvatarLink      xmlValue       `xml:"avatarlink"`
+	YearRegistered  xmlValue       `xml:"yearregistered"`
+	LastLogin       xmlValue       `xml:"lastlogin"`
+	StateOrProvince xmlValue       `xml:"stateorprovince"`
+	Country         xmlValue       `xml:"country"`
+	WebAddress      xmlValue       `xml:"webaddress"`
+	TradeRating     xmlValue       `xml:"traderating"`
+	Buddies         xmlUserBuddies `xml:"buddies"`
+	Guilds          xmlUserGuilds  `xml:"guilds"`
+	Hot             *xmlUserRanked `xml:"hot"`
+	Top             *xmlUserRanked `xml:"top"`
+}
+
+// xmlUserBuddies contains a page of a user's buddies.
+type xmlUserBuddies struct {
+	Total int          `xml:"total,attr"`
+	Page  int          `xml:"page,attr"`
+	Items []xmlUserRef `xml:"buddy"`
+}
+
+// xmlUserGuilds contains a page of a user's guilds.
+type xmlUserGuilds struct {
+	Total int          `xml:"total,attr"`
+	Page  int          `xml:"page,attr"`
+	Items []xmlUserRef `xml:"guild"`
+}
+
+// xmlUserRef represents a buddy or guild entry.
+type xmlUserRef struct {
+	ID   int    `xml:"id,attr"`
+	Name string `xml:"name,attr"`
+}
+
+// xmlUserRanked represents a user's hot or top list.
+type xmlUserRanked struct {
+	Domain string              `xml:"domain,attr"`
+	Items  []xmlUserRankedItem `xml:"item"`
+}
+
+// xmlUserRankedItem represents an entry in a hot or top list.
+type xmlUserRankedItem struct {
+	Rank int    `xml:"rank,attr"`
+	Type string `xml:"type,attr"`
+	ID   int    `xml:"id,attr"`
+	Name string `xml:"name,attr"`
+}
+
+// xmlGuild is the root element for guild responses.
+type xmlGuild struct {
+	XMLName     xml.Name         `xml:"guild"`
+	ID          int              `xml:"id,attr"`
+	Name        string           `xml:"name,attr"`
+	Created     string           `xml:"created,attr"`
+	Error       string           `xml:"error"`
+	Category    string           `xml:"category"`
+	Website     string           `xml:"website"`
+	Manager     string           `xml:"manager"`
+	Description string           `xml:"description"`
+	Location    xmlGuildLocation `xml:"location"`
+	Members     *xmlGuildMembers `xml:"members"`
+}
+
+// xmlGuildLocation represents a guild's location.
+type xmlGuildLocation struct {
+	Addr1           string `xml:"addr1"`
+	Addr2           string `xml:"addr2"`
+	City            string `xml:"city"`
+	StateOrProvince string `xml:"stateorprovince"`
+	PostalCode      string `xml:"postalcode"`
+	Country         string `xml:"country"`
+}
+
+// xmlGuildMembers contains a page of guild members.
+type xmlGuildMembers struct {
+	Count   int              `xml:"count,attr"`
+	Page    int              `xml:"page,attr"`
+	Members []xmlGuildMember `xml:"member"`
+}
+
+// xmlGuildMember represents a member of a guild.
+type xmlGuildMember struct {
+	Name string `xml:"name,attr"`
+	Date string `xml:"date,attr"`
+}
+
+// xmlFamily is the root element for family responses.
+type xmlFamily struct {
+	XMLName xml.Name        `xml:"items"`
+	Items   []xmlFamilyItem `xml:"item"`
+}
+
+// xmlFamilyItem represents a family item.
+type xmlFamilyItem struct {
+	Type        string        `xml:"type,attr"`
+	ID          int           `xml:"id,attr"`
+	Thumbnail   string        `xml:"thumbnail"`
+	Image       string        `xml:"image"`
+	Names       []xmlNameElem `xml:"name"`
+	Description string        `xml:"description"`
+	Links       []xmlLink     `xml:"link"`
+}
+
+// xmlGeekList is the root element for geeklist responses (XML API v1).
+type xmlGeekList struct {
+	XMLName     xml.Name             `xml:"geeklist"`
+	ID          int                  `xml:"id,attr"`
+	PostDate    string               `xml:"postdate"`
+	EditDate    string               `xml:"editdate"`
+	Thumbs      int                  `xml:"thumbs"`
+	NumItems    int                  `xml:"numitems"`
+	Username    string               `xml:"username"`
+	Title       string               `xml:"title"`
+	Description string               `xml:"description"`
+	Comments    []xmlGeekListComment `xml:"comment"`
+	Items       []xmlGeekListItem    `xml:"item"`
+}
+
+// xmlGeekListItem represents an item in a geeklist.
+type xmlGeekListItem struct {
+	ID         int                  `xml:"id,attr"`
+	ObjectType string               `xml:"objecttype,attr"`
+	Subtype    string               `xml:"subtype,attr"`
+	ObjectID   int                  `xml:"objectid,attr"`
+	ObjectName string               `xml:"objectname,attr"`
+	Username   string               `xml:"username,attr"`
+	PostDate   string               `xml:"postdate,attr"`
+	EditDate   string               `xml:"editdate,attr"`
+	Thumbs     int                  `xml:"thumbs,attr"`
+	ImageID    int                  `xml:"imageid,attr"`
+	Body       string               `xml:"body"`
+	Comments   []xmlGeekListComment `xml:"comment"`
+}
+
+// xmlGeekListComment represents a comment on a geeklist or geeklist item.
+type xmlGeekListComment struct {
+	Username string `xml:"username,attr"`
+	PostDate string `xml:"postdate,attr"`
+	EditDate string `xml:"editdate,attr"`
+	Thumbs   int    `xml:"thumbs,attr"`
+	Body     string `xml:",chardata"`
+}
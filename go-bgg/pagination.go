@@ -0,0 +1,92 @@
+package bgg
+
+import (
+	"context"
+	"iter"
+)
+
+// commentsMaxPageSize is the largest comment page size BGG accepts.
+const commentsMaxPageSize = 100
+
+// AllForumThreads iterates over every thread in a forum, fetching pages of
+// 50 threads lazily as the loop advances. Iteration stops after the last
+// page; on an error, including cancellation of ctx, the error is yielded
+// once and iteration ends.
+//
+//	for thread, err := range client.AllForumThreads(ctx, forumID) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(thread.Subject)
+//	}
+func (c *Client) AllForumThreads(ctx context.Context, forumID int) iter.Seq2[ThreadSummary, error] {
+	return paginate(ctx, 1, func(ctx context.Context, page int) ([]ThreadSummary, int, error) {
+		list, err := c.GetForumThreadsContext(ctx, forumID, page)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.Threads, list.TotalPages, nil
+	})
+}
+
+// AllPlays iterates over every play logged by a user (or of opts.ID if
+// username is empty), starting at opts.Page. Pages of 100 plays are fetched
+// lazily; errors end the iteration as in AllForumThreads.
+func (c *Client) AllPlays(ctx context.Context, username string, opts PlaysOptions) iter.Seq2[Play, error] {
+	return paginate(ctx, opts.Page, func(ctx context.Context, page int) ([]Play, int, error) {
+		opts.Page = page
+		list, err := c.GetPlaysContext(ctx, username, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.Plays, list.TotalPages, nil
+	})
+}
+
+// AllComments iterates over every user comment on a game, fetching pages of
+// 100 comments lazily; errors end the iteration as in AllForumThreads.
+func (c *Client) AllComments(ctx context.Context, gameID int) iter.Seq2[Comment, error] {
+	return paginate(ctx, 1, func(ctx context.Context, page int) ([]Comment, int, error) {
+		game, err := c.GetGameWithOptionsContext(ctx, gameID, GameOptions{
+			Comments: true,
+			Page:     page,
+			PageSize: commentsMaxPageSize,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		if game.Comments == nil {
+			return nil, 0, nil
+		}
+		return game.Comments.Comments, game.Comments.TotalPages, nil
+	})
+}
+
+// paginate yields the items of consecutive pages from start (at least 1)
+// until the last or an empty page. fetch returns a page and the total
+// number of pages. An error is yielded once and ends the iteration.
+func paginate[T any](ctx context.Context, start int, fetch func(ctx context.Context, page int) ([]T, int, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for page := max(start, 1); ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(zero, newNetworkError("request canceled", 0, err))
+				return
+			}
+
+			items, totalPages, err := fetch(ctx, page)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(items) == 0 || page >= totalPages {
+				return
+			}
+		}
+	}
+}
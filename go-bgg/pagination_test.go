@@ -0,0 +1,218 @@
+package bgg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// newForumPagesServer serves a forum of numThreads threads, 50 per page.
+// Requests for failPage answer 500.
+func newForumPagesServer(t *testing.T, numThreads, failPage int) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == failPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var b strings.Builder
+		fmt.Fprintf(&b, `<forum id="21" numthreads="%d"><threads>`, numThreads)
+		for id := (page-1)*50 + 1; id <= min(page*50, numThreads); id++ {
+			fmt.Fprintf(&b, `<thread id="%d" subject="Thread %d"/>`, id, id)
+		}
+		b.WriteString(`</threads></forum>`)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(b.String()))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestAllForumThreads(t *testing.T) {
+	server, requests := newForumPagesServer(t, 120, 0)
+	client := createTestClient(t, server)
+
+	var ids []int
+	for thread, err := range client.AllForumThreads(context.Background(), 21) {
+		if err != nil {
+			t.Fatalf("AllForumThreads error = %v", err)
+		}
+		ids = append(ids, thread.ID)
+	}
+
+	if len(ids) != 120 {
+		t.Fatalf("expected 120 threads, got %d", len(ids))
+	}
+	for i, id := range ids {
+		if id != i+1 {
+			t.Fatalf("ids[%d] = %d, want %d", i, id, i+1)
+		}
+	}
+	if *requests != 3 {
+		t.Errorf("expected 3 page requests, got %d", *requests)
+	}
+}
+
+func TestAllForumThreads_Break(t *testing.T) {
+	server, requests := newForumPagesServer(t, 120, 0)
+	client := createTestClient(t, server)
+
+	n := 0
+	for _, err := range client.AllForumThreads(context.Background(), 21) {
+		if err != nil {
+			t.Fatalf("AllForumThreads error = %v", err)
+		}
+		if n++; n == 60 {
+			break
+		}
+	}
+
+	if *requests != 2 {
+		t.Errorf("expected pages to be fetched lazily (2 requests), got %d", *requests)
+	}
+}
+
+func TestAllForumThreads_Error(t *testing.T) {
+	server, requests := newForumPagesServer(t, 120, 2)
+	client := createTestClient(t, server)
+
+	n := 0
+	var errs []error
+	for _, err := range client.AllForumThreads(context.Background(), 21) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		n++
+	}
+
+	if n != 50 {
+		t.Errorf("expected the 50 threads of page 1, got %d", n)
+	}
+	var netErr *NetworkError
+	if len(errs) != 1 || !errors.As(errs[0], &netErr) || netErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected a single 500 NetworkError, got %v", errs)
+	}
+	if *requests != 2 {
+		t.Errorf("expected iteration to stop after the failed page, got %d requests", *requests)
+	}
+}
+
+func TestAllForumThreads_Cancel(t *testing.T) {
+	server, requests := newForumPagesServer(t, 120, 0)
+	client := createTestClient(t, server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	n := 0
+	var lastErr error
+	for _, err := range client.AllForumThreads(ctx, 21) {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if n++; n == 50 {
+			cancel()
+		}
+	}
+
+	if !errors.Is(lastErr, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", lastErr)
+	}
+	if *requests != 1 {
+		t.Errorf("expected no request after cancellation, got %d", *requests)
+	}
+}
+
+func TestAllPlays(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		pages = append(pages, q.Get("page"))
+		if q.Get("username") != "testuser" {
+			t.Errorf("expected username 'testuser', got %q", q.Get("username"))
+		}
+
+		page, _ := strconv.Atoi(q.Get("page"))
+		var b strings.Builder
+		b.WriteString(`<plays username="testuser" total="150">`)
+		for id := (page-1)*100 + 1; id <= min(page*100, 150); id++ {
+			fmt.Fprintf(&b, `<play id="%d" date="2025-01-12"/>`, id)
+		}
+		b.WriteString(`</plays>`)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(b.String()))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	n := 0
+	for _, err := range client.AllPlays(context.Background(), "testuser", PlaysOptions{}) {
+		if err != nil {
+			t.Fatalf("AllPlays error = %v", err)
+		}
+		n++
+	}
+
+	if n != 150 {
+		t.Errorf("expected 150 plays, got %d", n)
+	}
+	if strings.Join(pages, ",") != "1,2" {
+		t.Errorf("expected pages 1,2, got %v", pages)
+	}
+}
+
+func TestAllComments(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		q := r.URL.Query()
+		if q.Get("comments") != "1" || q.Get("pagesize") != "100" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		page, _ := strconv.Atoi(q.Get("page"))
+		var b strings.Builder
+		fmt.Fprintf(&b, `<items><item type="boardgame" id="13"><comments page="%d" totalitems="130">`, page)
+		for i := (page-1)*100 + 1; i <= min(page*100, 130); i++ {
+			fmt.Fprintf(&b, `<comment username="user%d" rating="N/A" value="comment %d"/>`, i, i)
+		}
+		b.WriteString(`</comments></item></items>`)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(b.String()))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	var comments []Comment
+	for comment, err := range client.AllComments(context.Background(), 13) {
+		if err != nil {
+			t.Fatalf("AllComments error = %v", err)
+		}
+		comments = append(comments, comment)
+	}
+
+	if len(comments) != 130 {
+		t.Fatalf("expected 130 comments, got %d", len(comments))
+	}
+	if comments[129].Username != "user130" {
+		t.Errorf("unexpected last comment: %+v", comments[129])
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
@@ -0,0 +1,140 @@
+package bgg
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// playsPerPage is the number of plays the Plays API returns per page.
+	playsPerPage = 100
+
+	// playsDateLayout is the date format used by the Plays API.
+	playsDateLayout = "2006-01-02"
+)
+
+// GetPlays retrieves a page of logged plays for a user.
+// If username is empty, opts.ID must be set to fetch plays of a single thing.
+// Each page returns up to 100 plays.
+func (c *Client) GetPlays(username string, opts PlaysOptions) (*PlayList, error) {
+	return c.GetPlaysContext(context.Background(), username, opts)
+}
+
+// GetPlaysContext is like GetPlays but uses ctx for the request.
+func (c *Client) GetPlaysContext(ctx context.Context, username string, opts PlaysOptions) (*PlayList, error) {
+	if username == "" && opts.ID <= 0 {
+		return nil, newParseError("username or game ID is required", nil)
+	}
+
+	page := opts.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	params := url.Values{}
+	if username != "" {
+		params.Set("username", username)
+	}
+	if opts.ID > 0 {
+		params.Set("id", strconv.Itoa(opts.ID))
+		params.Set("type", "thing")
+	}
+	if opts.Subtype != "" {
+		params.Set("subtype", opts.Subtype)
+	}
+	if !opts.MinDate.IsZero() {
+		params.Set("mindate", opts.MinDate.Format(playsDateLayout))
+	}
+	if !opts.MaxDate.IsZero() {
+		params.Set("maxdate", opts.MaxDate.Format(playsDateLayout))
+	}
+	params.Set("page", strconv.Itoa(page))
+
+	endpoint := fmt.Sprintf("/plays?%s", params.Encode())
+	body, err := c.doRequest(ctx, endpoint)
+	if err != nil {
+		return nil, withUsername(err, username)
+	}
+
+	xmlResp, err := parseXML[xmlPlays](body, "failed to parse plays response")
+	if err != nil {
+		return nil, err
+	}
+
+	plays := make([]Play, 0, len(xmlResp.Plays))
+	for _, p := range xmlResp.Plays {
+		plays = append(plays, convertXMLToPlay(p))
+	}
+
+	// Calculate total pages (100 plays per page)
+	totalPages := (xmlResp.Total + playsPerPage - 1) / playsPerPage
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	return &PlayList{
+		Username:   xmlResp.Username,
+		UserID:     xmlResp.UserID,
+		Total:      xmlResp.Total,
+		Page:       page,
+		TotalPages: totalPages,
+		Plays:      plays,
+	}, nil
+}
+
+// GetPlaysJSON retrieves a page of logged plays for a user and returns JSON.
+func (c *Client) GetPlaysJSON(username string, opts PlaysOptions) (string, error) {
+	return c.GetPlaysJSONContext(context.Background(), username, opts)
+}
+
+// GetPlaysJSONContext is like GetPlaysJSON but uses ctx for the request.
+func (c *Client) GetPlaysJSONContext(ctx context.Context, username string, opts PlaysOptions) (string, error) {
+	playList, err := c.GetPlaysContext(ctx, username, opts)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(playList)
+}
+
+// convertXMLToPlay converts an XML play to a Play struct.
+func convertXMLToPlay(p xmlPlay) Play {
+	play := Play{
+		ID:         p.ID,
+		Date:       p.Date,
+		Time:       parseTime(p.Date),
+		Quantity:   p.Quantity,
+		Length:     p.Length,
+		Incomplete: p.Incomplete == 1,
+		NoWinStats: p.NoWinStats == 1,
+		Location:   p.Location,
+		Comments:   p.Comments,
+		GameID:     p.Item.ObjectID,
+		GameName:   p.Item.Name,
+		ObjectType: p.Item.ObjectType,
+	}
+
+	for _, st := range p.Item.Subtypes {
+		play.Subtypes = append(play.Subtypes, st.Value)
+	}
+
+	for _, pl := range p.Players {
+		player := PlayPlayer{
+			Username:      pl.Username,
+			UserID:        pl.UserID,
+			Name:          pl.Name,
+			StartPosition: pl.StartPosition,
+			Color:         pl.Color,
+			Score:         pl.Score,
+			New:           pl.New == 1,
+			Win:           pl.Win == 1,
+		}
+		if r, err := strconv.ParseFloat(pl.Rating, 64); err == nil {
+			player.Rating = r
+		}
+		play.Players = append(play.Players, player)
+	}
+
+	return play
+}
@@ -0,0 +1,265 @@
+package bgg
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestGetPlays(t *testing.T) {
+	testData, err := os.ReadFile("testdata/plays_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request path
+		if r.URL.Path != "/plays" {
+			t.Errorf("expected path '/plays', got '%s'", r.URL.Path)
+		}
+
+		// Verify query parameters
+		username := r.URL.Query().Get("username")
+		if username != "testuser" {
+			t.Errorf("expected username 'testuser', got '%s'", username)
+		}
+
+		page := r.URL.Query().Get("page")
+		if page != "1" {
+			t.Errorf("expected page '1', got '%s'", page)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	playList, err := client.GetPlays("testuser", PlaysOptions{})
+	if err != nil {
+		t.Fatalf("GetPlays failed: %v", err)
+	}
+
+	if playList.Username != "testuser" {
+		t.Errorf("expected Username 'testuser', got '%s'", playList.Username)
+	}
+	if playList.UserID != 123456 {
+		t.Errorf("expected UserID 123456, got %d", playList.UserID)
+	}
+	if playList.Total != 205 {
+		t.Errorf("expected Total 205, got %d", playList.Total)
+	}
+	if playList.Page != 1 {
+		t.Errorf("expected Page 1, got %d", playList.Page)
+	}
+	if playList.TotalPages != 3 {
+		t.Errorf("expected TotalPages 3, got %d", playList.TotalPages)
+	}
+
+	if len(playList.Plays) != 2 {
+		t.Fatalf("expected 2 plays, got %d", len(playList.Plays))
+	}
+
+	// Verify first play
+	play := playList.Plays[0]
+	if play.ID != 80000001 {
+		t.Errorf("expected ID 80000001, got %d", play.ID)
+	}
+	if play.Date != "2025-01-12" {
+		t.Errorf("expected Date '2025-01-12', got '%s'", play.Date)
+	}
+	if want := time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC); !play.Time.Equal(want) {
+		t.Errorf("expected Time %v, got %v", want, play.Time)
+	}
+	if play.Quantity != 1 {
+		t.Errorf("expected Quantity 1, got %d", play.Quantity)
+	}
+	if play.Length != 95 {
+		t.Errorf("expected Length 95, got %d", play.Length)
+	}
+	if play.Incomplete {
+		t.Error("expected Incomplete to be false")
+	}
+	if play.Location != "Home" {
+		t.Errorf("expected Location 'Home', got '%s'", play.Location)
+	}
+	if play.Comments != "Longest road decided it." {
+		t.Errorf("unexpected Comments: '%s'", play.Comments)
+	}
+	if play.GameID != 13 {
+		t.Errorf("expected GameID 13, got %d", play.GameID)
+	}
+	if play.GameName != "CATAN" {
+		t.Errorf("expected GameName 'CATAN', got '%s'", play.GameName)
+	}
+	if len(play.Subtypes) != 1 || play.Subtypes[0] != "boardgame" {
+		t.Errorf("expected Subtypes [boardgame], got %v", play.Subtypes)
+	}
+
+	// Verify players
+	if len(play.Players) != 3 {
+		t.Fatalf("expected 3 players, got %d", len(play.Players))
+	}
+	winner := play.Players[0]
+	if winner.Username != "testuser" || winner.UserID != 123456 {
+		t.Errorf("unexpected first player: %+v", winner)
+	}
+	if winner.Score != "10" {
+		t.Errorf("expected Score '10', got '%s'", winner.Score)
+	}
+	if winner.Color != "Red" {
+		t.Errorf("expected Color 'Red', got '%s'", winner.Color)
+	}
+	if !winner.Win {
+		t.Error("expected first player to win")
+	}
+	if winner.Rating != 8 {
+		t.Errorf("expected Rating 8, got %f", winner.Rating)
+	}
+	if !play.Players[1].New {
+		t.Error("expected second player to be new")
+	}
+	if play.Players[1].Win {
+		t.Error("expected second player not to win")
+	}
+	if play.Players[2].Rating != 7.5 {
+		t.Errorf("expected Rating 7.5, got %f", play.Players[2].Rating)
+	}
+
+	// Verify second play flags
+	second := playList.Plays[1]
+	if !second.Incomplete {
+		t.Error("expected second play to be incomplete")
+	}
+	if !second.NoWinStats {
+		t.Error("expected second play to have NoWinStats")
+	}
+	if second.Quantity != 2 {
+		t.Errorf("expected Quantity 2, got %d", second.Quantity)
+	}
+	if len(second.Players) != 0 {
+		t.Errorf("expected no players, got %d", len(second.Players))
+	}
+}
+
+func TestGetPlays_Options(t *testing.T) {
+	testData, err := os.ReadFile("testdata/plays_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		want := map[string]string{
+			"username": "testuser",
+			"id":       "13",
+			"type":     "thing",
+			"subtype":  "boardgame",
+			"mindate":  "2024-01-01",
+			"maxdate":  "2024-12-31",
+			"page":     "3",
+		}
+		for k, v := range want {
+			if got := q.Get(k); got != v {
+				t.Errorf("expected %s '%s', got '%s'", k, v, got)
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	playList, err := client.GetPlays("testuser", PlaysOptions{
+		ID:      13,
+		Subtype: "boardgame",
+		MinDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		MaxDate: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		Page:    3,
+	})
+	if err != nil {
+		t.Fatalf("GetPlays failed: %v", err)
+	}
+	if playList.Page != 3 {
+		t.Errorf("expected Page 3, got %d", playList.Page)
+	}
+}
+
+func TestGetPlays_ByGameOnly(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("username") {
+			t.Error("expected no username parameter")
+		}
+		if id := r.URL.Query().Get("id"); id != "13" {
+			t.Errorf("expected id '13', got '%s'", id)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<plays total="0" page="1"></plays>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	playList, err := client.GetPlays("", PlaysOptions{ID: 13})
+	if err != nil {
+		t.Fatalf("GetPlays failed: %v", err)
+	}
+	if len(playList.Plays) != 0 {
+		t.Errorf("expected 0 plays, got %d", len(playList.Plays))
+	}
+	if playList.TotalPages != 1 {
+		t.Errorf("expected TotalPages 1, got %d", playList.TotalPages)
+	}
+}
+
+func TestGetPlays_MissingUsernameAndID(t *testing.T) {
+	client, _ := NewClient(Config{Token: "test-token"})
+
+	_, err := client.GetPlays("", PlaysOptions{})
+	if err == nil {
+		t.Error("expected error for missing username and ID")
+	}
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Errorf("expected ParseError, got %T", err)
+	}
+}
+
+func TestGetPlaysJSON(t *testing.T) {
+	testData, err := os.ReadFile("testdata/plays_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	jsonStr, err := client.GetPlaysJSON("testuser", PlaysOptions{})
+	if err != nil {
+		t.Fatalf("GetPlaysJSON failed: %v", err)
+	}
+
+	// Verify it's valid JSON
+	var playList PlayList
+	if err := json.Unmarshal([]byte(jsonStr), &playList); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+
+	if len(playList.Plays) != 2 {
+		t.Errorf("expected 2 plays, got %d", len(playList.Plays))
+	}
+}
@@ -0,0 +1,99 @@
+package bgg
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Burst(t *testing.T) {
+	l := newRateLimiter(20, 2)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		if err := l.wait(ctx); err != nil {
+			t.Fatalf("wait() error = %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 20*time.Millisecond {
+		t.Errorf("burst requests should not wait, took %v", elapsed)
+	}
+
+	// The third request has to wait for a token (1/20s)
+	if err := l.wait(ctx); err != nil {
+		t.Fatalf("wait() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("expected third request to wait ~50ms, took %v", elapsed)
+	}
+}
+
+func TestRateLimiter_Pause(t *testing.T) {
+	l := newRateLimiter(1000, 10)
+	l.pause(80 * time.Millisecond)
+
+	start := time.Now()
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatalf("wait() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 70*time.Millisecond {
+		t.Errorf("expected wait to honor pause, took %v", elapsed)
+	}
+}
+
+func TestRateLimiter_Canceled(t *testing.T) {
+	l := newRateLimiter(1, 1)
+	l.pause(time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := l.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("wait() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestNewClient_RateLimit(t *testing.T) {
+	client, _ := NewClient(Config{Token: "test-token"})
+	if client.limiter == nil || client.limiter.rate != DefaultRateLimit || client.limiter.burst != DefaultRateBurst {
+		t.Errorf("expected default limiter, got %+v", client.limiter)
+	}
+
+	client, _ = NewClient(Config{Token: "test-token", RateLimit: 0.5, RateBurst: 1})
+	if client.limiter == nil || client.limiter.rate != 0.5 || client.limiter.burst != 1 {
+		t.Errorf("expected custom limiter, got %+v", client.limiter)
+	}
+
+	client, _ = NewClient(Config{Token: "test-token", RateLimit: -1})
+	if client.limiter != nil {
+		t.Error("expected negative RateLimit to disable the limiter")
+	}
+}
+
+func TestDoRequest_429PausesLimiter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+	client.limiter = newRateLimiter(100, 10)
+
+	_, err := client.doRequestWithRetryOn202(context.Background(), "/collection", 0)
+	var rateLimitErr *RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("expected RateLimitError, got %v", err)
+	}
+
+	// Other requests of the same client now wait for Retry-After
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.doRequest(ctx, "/hot"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected request to block on the paused limiter, got %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+package bgg
+
+import (
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy decides whether a failed request is retried and how long to
+// wait first. A Retry-After delay sent by BGG is honored on top of it.
+type RetryPolicy interface {
+	// Backoff returns the delay before retry n (1 for the first retry) after
+	// the attempt that failed with err, or false to give up.
+	Backoff(n int, err error) (time.Duration, bool)
+}
+
+// ExponentialBackoff doubles the delay after each failed attempt.
+type ExponentialBackoff struct {
+	Base       time.Duration // Delay before the first retry (default: 2s)
+	Max        time.Duration // Upper bound for a single delay (default: none)
+	MaxRetries int           // Retries after the first attempt (default: 3, negative disables)
+	Jitter     float64       // Fraction of each delay that is randomized, 0 to 1
+}
+
+// Backoff implements RetryPolicy.
+func (p *ExponentialBackoff) Backoff(n int, err error) (time.Duration, bool) {
+	if n > retriesOrDefault(p.MaxRetries, DefaultRetryCount) {
+		return 0, false
+	}
+
+	base := p.Base
+	if base == 0 {
+		base = DefaultRetryDelay
+	}
+	shift := min(n-1, 30)
+	delay := base << shift
+	if delay>>shift != base {
+		delay = math.MaxInt64 // overflow
+	}
+	if p.Max > 0 && delay > p.Max {
+		delay = p.Max
+	}
+
+	if p.Jitter > 0 {
+		// Spread retries of concurrent requests over [delay*(1-Jitter), delay]
+		delay -= time.Duration(rand.Float64() * min(p.Jitter, 1) * float64(delay))
+	}
+	return delay, true
+}
+
+// FixedBackoff waits the same delay before every retry.
+type FixedBackoff struct {
+	Delay      time.Duration // Delay before each retry (default: 2s)
+	MaxRetries int           // Retries after the first attempt (default: 3, negative disables)
+}
+
+// Backoff implements RetryPolicy.
+func (p *FixedBackoff) Backoff(n int, err error) (time.Duration, bool) {
+	if n > retriesOrDefault(p.MaxRetries, DefaultRetryCount) {
+		return 0, false
+	}
+	if p.Delay == 0 {
+		return DefaultRetryDelay, true
+	}
+	return p.Delay, true
+}
+
+// retriesOrDefault returns n, def if n is zero, or 0 if n is negative.
+func retriesOrDefault(n, def int) int {
+	switch {
+	case n == 0:
+		return def
+	case n < 0:
+		return 0
+	}
+	return n
+}
+
+// maxRetriesOf returns the retry limit of the built-in policies.
+func maxRetriesOf(p RetryPolicy) (int, bool) {
+	switch p := p.(type) {
+	case *ExponentialBackoff:
+		return retriesOrDefault(p.MaxRetries, DefaultRetryCount), true
+	case *FixedBackoff:
+		return retriesOrDefault(p.MaxRetries, DefaultRetryCount), true
+	}
+	return 0, false
+}
+
+// RetryPolicies overrides Config.RetryPolicy for specific endpoints.
+// Nil fields use the client's default.
+type RetryPolicies struct {
+	Thing      RetryPolicy // /thing and /family
+	Collection RetryPolicy // /collection, e.g. to wait longer for BGG to build large collections
+}
+
+// policyFor returns the override for the given endpoint, or nil.
+func (p RetryPolicies) policyFor(endpoint string) RetryPolicy {
+	path := endpoint
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+
+	switch path {
+	case "/thing", "/family":
+		return p.Thing
+	case "/collection":
+		return p.Collection
+	}
+	return nil
+}
+
+// parseRetryAfter extracts the Retry-After duration from an HTTP response
+// header, given either in seconds or as an HTTP date.
+func parseRetryAfter(header http.Header, defaultDelay time.Duration) time.Duration {
+	ra := strings.TrimSpace(header.Get("Retry-After"))
+	if ra == "" {
+		return defaultDelay
+	}
+	if secs, err := strconv.Atoi(ra); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(ra); err == nil {
+		return max(time.Until(t), 0)
+	}
+	return defaultDelay
+}
@@ -0,0 +1,151 @@
+package bgg
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// retryFunc adapts a function to RetryPolicy.
+type retryFunc func(n int, err error) (time.Duration, bool)
+
+func (f retryFunc) Backoff(n int, err error) (time.Duration, bool) { return f(n, err) }
+
+func TestExponentialBackoff(t *testing.T) {
+	p := &ExponentialBackoff{Base: 100 * time.Millisecond, Max: 350 * time.Millisecond, MaxRetries: 4}
+
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 350 * time.Millisecond, 350 * time.Millisecond}
+	for i, w := range want {
+		got, ok := p.Backoff(i+1, nil)
+		if !ok || got != w {
+			t.Errorf("Backoff(%d) = %v, %v, want %v, true", i+1, got, ok, w)
+		}
+	}
+	if _, ok := p.Backoff(5, nil); ok {
+		t.Error("expected no retry after MaxRetries")
+	}
+
+	// Huge attempt numbers must not overflow into short delays
+	p = &ExponentialBackoff{Base: time.Hour, MaxRetries: 100}
+	if got, _ := p.Backoff(100, nil); got < time.Hour {
+		t.Errorf("Backoff(100) = %v, want at least 1h", got)
+	}
+}
+
+func TestExponentialBackoff_Jitter(t *testing.T) {
+	p := &ExponentialBackoff{Base: time.Second, Jitter: 0.5}
+	for i := 0; i < 100; i++ {
+		got, ok := p.Backoff(2, nil)
+		if !ok || got < time.Second || got > 2*time.Second {
+			t.Fatalf("Backoff(2) = %v, want between 1s and 2s", got)
+		}
+	}
+}
+
+func TestFixedBackoff(t *testing.T) {
+	p := &FixedBackoff{}
+	for n := 1; n <= DefaultRetryCount; n++ {
+		if got, ok := p.Backoff(n, nil); !ok || got != DefaultRetryDelay {
+			t.Errorf("Backoff(%d) = %v, %v, want %v, true", n, got, ok, DefaultRetryDelay)
+		}
+	}
+	if _, ok := p.Backoff(DefaultRetryCount+1, nil); ok {
+		t.Error("expected no retry after the default retry count")
+	}
+
+	p = &FixedBackoff{MaxRetries: -1}
+	if _, ok := p.Backoff(1, nil); ok {
+		t.Error("expected negative MaxRetries to disable retries")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	def := 5 * time.Second
+	tests := []struct {
+		name  string
+		value string
+		min   time.Duration
+		max   time.Duration
+	}{
+		{"seconds", "7", 7 * time.Second, 7 * time.Second},
+		{"zero", "0", 0, 0},
+		{"http date", time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat), 28 * time.Second, 30 * time.Second},
+		{"past http date", "Wed, 21 Oct 2015 07:28:00 GMT", 0, 0},
+		{"missing", "", def, def},
+		{"invalid", "soon", def, def},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.value != "" {
+				header.Set("Retry-After", tt.value)
+			}
+			got := parseRetryAfter(header, def)
+			if got < tt.min || got > tt.max {
+				t.Errorf("parseRetryAfter(%q) = %v, want between %v and %v", tt.value, got, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Config(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	var calls []int
+	client := createTestClient(t, server)
+	client.retryPolicy = retryFunc(func(n int, err error) (time.Duration, bool) {
+		calls = append(calls, n)
+		var netErr *NetworkError
+		if !errors.As(err, &netErr) || netErr.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("unexpected error passed to policy: %v", err)
+		}
+		return time.Millisecond, n <= 2
+	})
+
+	if _, err := client.GetHotGames(); err == nil {
+		t.Fatal("expected error after retries are exhausted")
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+	if len(calls) != 3 {
+		t.Errorf("expected policy to be asked 3 times, got %v", calls)
+	}
+}
+
+func TestRetryPolicies_Override(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+	client.retryPolicy = retryFunc(func(n int, err error) (time.Duration, bool) {
+		t.Error("default policy used for an overridden endpoint")
+		return 0, false
+	})
+	client.retryPolicies = RetryPolicies{
+		Collection: &FixedBackoff{Delay: time.Millisecond, MaxRetries: 4},
+	}
+
+	_, err := client.GetCollectionContext(context.Background(), "testuser", CollectionOptions{})
+	var processing *ProcessingError
+	if !errors.As(err, &processing) {
+		t.Fatalf("expected ProcessingError, got %T: %v", err, err)
+	}
+	if attempts != 5 {
+		t.Errorf("expected 5 attempts, got %d", attempts)
+	}
+}
@@ -1,6 +1,7 @@
 package bgg
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -8,13 +9,51 @@ import (
 // SearchGames searches for board games by name.
 // Returns a list of matching games.
 func (c *Client) SearchGames(query string) ([]GameSearchResult, error) {
+	return c.SearchGamesContext(context.Background(), query)
+}
+
+// SearchGamesContext is like SearchGames but uses ctx for the request.
+func (c *Client) SearchGamesContext(ctx context.Context, query string) ([]GameSearchResult, error) {
+	return c.SearchContext(ctx, query, SearchOptions{
+		Types: []ThingType{ThingBoardGame, ThingBoardGameExpansion},
+	})
+}
+
+// SearchGamesJSON searches for board games by name and returns JSON.
+func (c *Client) SearchGamesJSON(query string) (string, error) {
+	return c.SearchGamesJSONContext(context.Background(), query)
+}
+
+// SearchGamesJSONContext is like SearchGamesJSON but uses ctx for the request.
+func (c *Client) SearchGamesJSONContext(ctx context.Context, query string) (string, error) {
+	results, err := c.SearchGamesContext(ctx, query)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(results)
+}
+
+// Search searches for items of the given types by name.
+// Returns a list of matching items.
+func (c *Client) Search(query string, opts SearchOptions) ([]GameSearchResult, error) {
+	return c.SearchContext(context.Background(), query, opts)
+}
+
+// SearchContext is like Search but uses ctx for the request.
+func (c *Client) SearchContext(ctx context.Context, query string, opts SearchOptions) ([]GameSearchResult, error) {
 	if len(query) < 3 {
 		return nil, newParseError("search query must be at least 3 characters", nil)
 	}
 
-	endpoint := fmt.Sprintf("/search?query=%s&type=boardgame,boardgameexpansion", url.QueryEscape(query))
+	endpoint := fmt.Sprintf("/search?query=%s", url.QueryEscape(query))
+	if len(opts.Types) > 0 {
+		endpoint += "&type=" + joinThingTypes(opts.Types)
+	}
+	if opts.Exact {
+		endpoint += "&exact=1"
+	}
 
-	body, err := c.doRequest(endpoint)
+	body, err := c.doRequest(ctx, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -37,9 +76,14 @@ func (c *Client) SearchGames(query string) ([]GameSearchResult, error) {
 	return results, nil
 }
 
-// SearchGamesJSON searches for board games by name and returns JSON.
-func (c *Client) SearchGamesJSON(query string) (string, error) {
-	results, err := c.SearchGames(query)
+// SearchJSON searches for items of the given types by name and returns JSON.
+func (c *Client) SearchJSON(query string, opts SearchOptions) (string, error) {
+	return c.SearchJSONContext(context.Background(), query, opts)
+}
+
+// SearchJSONContext is like SearchJSON but uses ctx for the request.
+func (c *Client) SearchJSONContext(ctx context.Context, query string, opts SearchOptions) (string, error) {
+	results, err := c.SearchContext(ctx, query, opts)
 	if err != nil {
 		return "", err
 	}
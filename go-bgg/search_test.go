@@ -20,6 +20,8 @@ func createTestClient(t *testing.T, server *httptest.Server) *Client {
 		retryCount: 0,
 		retryDelay: 100 * time.Millisecond,
 		baseURL:    server.URL,
+
+		legacyBaseURL: server.URL,
 	}
 }
 
@@ -123,6 +125,58 @@ func TestSearchGames_EmptyResults(t *testing.T) {
 	}
 }
 
+func TestSearch_Options(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("type") != "rpgitem,videogame" {
+			t.Errorf("expected type 'rpgitem,videogame', got '%s'", q.Get("type"))
+		}
+		if q.Get("exact") != "1" {
+			t.Errorf("expected exact '1', got '%s'", q.Get("exact"))
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<items total="1"><item type="rpgitem" id="54321"><name type="primary" value="Call of Cthulhu"/><yearpublished value="1981"/></item></items>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	results, err := client.Search("Call of Cthulhu", SearchOptions{
+		Types: []ThingType{ThingRPGItem, ThingVideoGame},
+		Exact: true,
+	})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Type != "rpgitem" || results[0].ID != 54321 || results[0].Year != "1981" {
+		t.Errorf("unexpected result: %+v", results[0])
+	}
+}
+
+func TestSearch_AllTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, k := range []string{"type", "exact"} {
+			if r.URL.Query().Has(k) {
+				t.Errorf("expected no %s parameter", k)
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<items total="0"></items>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	if _, err := client.Search("catan", SearchOptions{}); err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+}
+
 func TestSearchGamesJSON(t *testing.T) {
 	testData, err := os.ReadFile("testdata/search_response.xml")
 	if err != nil {
@@ -1,20 +1,66 @@
 package bgg
 
 import (
+	"context"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 )
 
+// commentsDefaultPageSize is the number of comments BGG returns per page
+// when no pagesize is given.
+const commentsDefaultPageSize = 100
+
 // GetGame retrieves detailed information about a single game.
 func (c *Client) GetGame(id int) (*Game, error) {
+	return c.GetGameContext(context.Background(), id)
+}
+
+// GetGameContext is like GetGame but uses ctx for the request.
+func (c *Client) GetGameContext(ctx context.Context, id int) (*Game, error) {
+	return c.GetGameWithOptionsContext(ctx, id, GameOptions{})
+}
+
+// GetGameWithOptions retrieves detailed information about a single game,
+// optionally including versions, videos, marketplace listings and comments.
+func (c *Client) GetGameWithOptions(id int, opts GameOptions) (*Game, error) {
+	return c.GetGameWithOptionsContext(context.Background(), id, opts)
+}
+
+// GetGameWithOptionsContext is like GetGameWithOptions but uses ctx for the request.
+func (c *Client) GetGameWithOptionsContext(ctx context.Context, id int, opts GameOptions) (*Game, error) {
 	if id <= 0 {
 		return nil, newNotFoundError(id)
 	}
 
-	endpoint := fmt.Sprintf("/thing?id=%d&stats=1", id)
+	params := url.Values{}
+	params.Set("id", strconv.Itoa(id))
+	params.Set("stats", "1")
+	for _, f := range []struct {
+		flag bool
+		key  string
+	}{
+		{opts.Versions, "versions"},
+		{opts.Videos, "videos"},
+		{opts.Marketplace, "marketplace"},
+		{opts.Comments, "comments"},
+		{opts.RatingComments, "ratingcomments"},
+	} {
+		if f.flag {
+			params.Set(f.key, "1")
+		}
+	}
+	if opts.Page > 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.PageSize > 0 {
+		params.Set("pagesize", strconv.Itoa(opts.PageSize))
+	}
 
-	body, err := c.doRequest(endpoint)
+	endpoint := fmt.Sprintf("/thing?%s", params.Encode())
+
+	body, err := c.doRequest(ctx, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -29,12 +75,39 @@ func (c *Client) GetGame(id int) (*Game, error) {
 	}
 
 	game := convertXMLToGame(xmlResp.Items[0])
+
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = commentsDefaultPageSize
+	}
+	convertXMLToGameExtras(&game, xmlResp.Items[0], pageSize)
+
 	return &game, nil
 }
 
 // GetGameJSON retrieves detailed information about a single game and returns JSON.
 func (c *Client) GetGameJSON(id int) (string, error) {
-	game, err := c.GetGame(id)
+	return c.GetGameJSONContext(context.Background(), id)
+}
+
+// GetGameJSONContext is like GetGameJSON but uses ctx for the request.
+func (c *Client) GetGameJSONContext(ctx context.Context, id int) (string, error) {
+	game, err := c.GetGameContext(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(game)
+}
+
+// GetGameWithOptionsJSON retrieves detailed information about a single game
+// with optional data and returns JSON.
+func (c *Client) GetGameWithOptionsJSON(id int, opts GameOptions) (string, error) {
+	return c.GetGameWithOptionsJSONContext(context.Background(), id, opts)
+}
+
+// GetGameWithOptionsJSONContext is like GetGameWithOptionsJSON but uses ctx for the request.
+func (c *Client) GetGameWithOptionsJSONContext(ctx context.Context, id int, opts GameOptions) (string, error) {
+	game, err := c.GetGameWithOptionsContext(ctx, id, opts)
 	if err != nil {
 		return "", err
 	}
@@ -43,6 +116,11 @@ func (c *Client) GetGameJSON(id int) (string, error) {
 
 // GetGames retrieves detailed information about multiple games (max 20).
 func (c *Client) GetGames(ids []int) ([]Game, error) {
+	return c.GetGamesContext(context.Background(), ids)
+}
+
+// GetGamesContext is like GetGames but uses ctx for the request.
+func (c *Client) GetGamesContext(ctx context.Context, ids []int) ([]Game, error) {
 	if len(ids) == 0 {
 		return []Game{}, nil
 	}
@@ -59,7 +137,7 @@ func (c *Client) GetGames(ids []int) ([]Game, error) {
 
 	endpoint := fmt.Sprintf("/thing?id=%s&stats=1", strings.Join(idStrs, ","))
 
-	body, err := c.doRequest(endpoint)
+	body, err := c.doRequest(ctx, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -77,14 +155,43 @@ func (c *Client) GetGames(ids []int) ([]Game, error) {
 	return games, nil
 }
 
-// convertXMLToGame converts an XML thing item to a Game struct.
-func convertXMLToGame(item xmlThingItem) Game {
-	game := Game{
+// convertXMLToThing converts an XML thing item to a Thing struct.
+func convertXMLToThing(item xmlThingItem) Thing {
+	thing := Thing{
 		ID:          item.ID,
+		Type:        ThingType(item.Type),
 		Year:        item.YearValue.Value,
 		Description: decodeHTML(item.Description),
 		Thumbnail:   item.Thumbnail,
 		Image:       item.Image,
+	}
+
+	// Get primary and alternate names
+	for _, name := range item.Names {
+		switch name.Type {
+		case "primary":
+			thing.Name = name.Value
+		case "alternate":
+			thing.AlternateNames = append(thing.AlternateNames, name.Value)
+		}
+	}
+
+	for _, l := range item.Links {
+		thing.Links = append(thing.Links, Link{
+			ID:      l.ID,
+			Type:    LinkType(l.Type),
+			Name:    l.Value,
+			Inbound: l.Inbound == "true",
+		})
+	}
+
+	return thing
+}
+
+// convertXMLToGame converts an XML thing item to a Game struct.
+func convertXMLToGame(item xmlThingItem) Game {
+	game := Game{
+		Thing:       convertXMLToThing(item),
 		MinPlayers:  item.MinPlayers.Value,
 		MaxPlayers:  item.MaxPlayers.Value,
 		PlayingTime: item.PlayingTime.Value,
@@ -93,27 +200,37 @@ func convertXMLToGame(item xmlThingItem) Game {
 		MinAge:      item.MinAge.Value,
 	}
 
-	// Get primary name
-	for _, name := range item.Names {
-		if name.Type == "primary" {
-			game.Name = name.Value
-			break
-		}
-	}
-
 	// Extract links by type
-	for _, link := range item.Links {
+	for _, link := range game.Links {
 		switch link.Type {
-		case "boardgamedesigner":
-			game.Designers = append(game.Designers, link.Value)
-		case "boardgameartist":
-			game.Artists = append(game.Artists, link.Value)
-		case "boardgamepublisher":
-			game.Publishers = append(game.Publishers, link.Value)
-		case "boardgamecategory":
-			game.Categories = append(game.Categories, link.Value)
-		case "boardgamemechanic":
-			game.Mechanics = append(game.Mechanics, link.Value)
+		case LinkDesigner:
+			game.Designers = append(game.Designers, link.Name)
+			game.DesignerLinks = append(game.DesignerLinks, link)
+		case LinkArtist:
+			game.Artists = append(game.Artists, link.Name)
+			game.ArtistLinks = append(game.ArtistLinks, link)
+		case LinkPublisher:
+			game.Publishers = append(game.Publishers, link.Name)
+			game.PublisherLinks = append(game.PublisherLinks, link)
+		case LinkCategory:
+			game.Categories = append(game.Categories, link.Name)
+			game.CategoryLinks = append(game.CategoryLinks, link)
+		case LinkMechanic:
+			game.Mechanics = append(game.Mechanics, link.Name)
+			game.MechanicLinks = append(game.MechanicLinks, link)
+		case LinkFamily:
+			game.Families = append(game.Families, link.Name)
+			game.FamilyLinks = append(game.FamilyLinks, link)
+		case LinkExpansion:
+			game.Expansions = append(game.Expansions, link)
+		case LinkAccessory:
+			game.Accessories = append(game.Accessories, link)
+		case LinkImplementation:
+			game.Implementations = append(game.Implementations, link)
+		case LinkIntegration:
+			game.Integrations = append(game.Integrations, link)
+		case LinkCompilation:
+			game.Compilations = append(game.Compilations, link)
 		}
 	}
 
@@ -130,45 +247,259 @@ func convertXMLToGame(item xmlThingItem) Game {
 
 	// Extract rank (board game rank)
 	game.Rank = extractBoardGameRank(item.Statistics.Ratings.Ranks.Ranks)
+	game.Ranks = convertXMLToRanks(item.Statistics.Ratings.Ranks.Ranks)
 
-	// Extract suggested_numplayers poll
+	// Extract polls
 	for _, poll := range item.Polls {
-		if poll.Name != "suggested_numplayers" {
+		switch poll.Name {
+		case "suggested_numplayers":
+			game.PlayerCountPoll = convertXMLToPlayerCountPoll(poll, item.PollSummaries)
+		case "suggested_playerage":
+			game.AgePoll = convertXMLToAgePoll(poll)
+		case "language_dependence":
+			game.LanguageDependencePoll = convertXMLToLanguageDependencePoll(poll)
+		}
+	}
+
+	for _, ps := range item.PollSummaries {
+		summary := PollSummary{
+			Name:    ps.Name,
+			Title:   ps.Title,
+			Results: make(map[string]string, len(ps.Results)),
+		}
+		for _, r := range ps.Results {
+			summary.Results[r.Name] = r.Value
+		}
+		game.PollSummaries = append(game.PollSummaries, summary)
+	}
+
+	return game
+}
+
+// GetThings retrieves the common fields of multiple items of any type (max 20).
+// If types is non-empty, only items of those types are returned.
+func (c *Client) GetThings(ids []int, types []ThingType) ([]Thing, error) {
+	return c.GetThingsContext(context.Background(), ids, types)
+}
+
+// GetThingsContext is like GetThings but uses ctx for the request.
+func (c *Client) GetThingsContext(ctx context.Context, ids []int, types []ThingType) ([]Thing, error) {
+	if len(ids) == 0 {
+		return []Thing{}, nil
+	}
+
+	if len(ids) > 20 {
+		return nil, newParseError("maximum 20 things can be requested at once", nil)
+	}
+
+	idStrs := make([]string, len(ids))
+	for i, id := range ids {
+		idStrs[i] = strconv.Itoa(id)
+	}
+
+	endpoint := fmt.Sprintf("/thing?id=%s", strings.Join(idStrs, ","))
+	if len(types) > 0 {
+		endpoint += "&type=" + joinThingTypes(types)
+	}
+
+	body, err := c.doRequest(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	xmlResp, err := parseXML[xmlThing](body, "failed to parse thing response")
+	if err != nil {
+		return nil, err
+	}
+
+	things := make([]Thing, 0, len(xmlResp.Items))
+	for _, item := range xmlResp.Items {
+		things = append(things, convertXMLToThing(item))
+	}
+
+	return things, nil
+}
+
+// GetThingsJSON retrieves multiple items of any type and returns JSON.
+func (c *Client) GetThingsJSON(ids []int, types []ThingType) (string, error) {
+	return c.GetThingsJSONContext(context.Background(), ids, types)
+}
+
+// GetThingsJSONContext is like GetThingsJSON but uses ctx for the request.
+func (c *Client) GetThingsJSONContext(ctx context.Context, ids []int, types []ThingType) (string, error) {
+	things, err := c.GetThingsContext(ctx, ids, types)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(things)
+}
+
+// convertXMLToGameExtras fills in the optional sections requested through
+// GameOptions: versions, videos, marketplace listings and comments.
+func convertXMLToGameExtras(game *Game, item xmlThingItem, pageSize int) {
+	if item.Versions != nil {
+		for _, v := range item.Versions.Items {
+			game.Versions = append(game.Versions, convertXMLToVersion(v))
+		}
+	}
+
+	if item.Videos != nil {
+		for _, v := range item.Videos.Items {
+			game.Videos = append(game.Videos, Video{
+				ID:       v.ID,
+				Title:    v.Title,
+				Category: v.Category,
+				Language: v.Language,
+				Link:     v.Link,
+				Username: v.Username,
+				UserID:   v.UserID,
+				PostDate: v.PostDate,
+				PostTime: parseTime(v.PostDate),
+			})
+		}
+	}
+
+	if item.Marketplace != nil {
+		for _, l := range item.Marketplace.Listings {
+			listing := MarketplaceListing{
+				ListDate:  l.ListDate.Value,
+				ListTime:  parseTime(l.ListDate.Value),
+				Currency:  l.Price.Currency,
+				Condition: l.Condition.Value,
+				Notes:     decodeHTML(l.Notes.Value),
+				Link:      l.Link.Href,
+			}
+			listing.Price, _ = strconv.ParseFloat(l.Price.Value, 64)
+			game.MarketplaceListings = append(game.MarketplaceListings, listing)
+		}
+	}
+
+	if item.Comments != nil {
+		list := &CommentList{
+			Page:       item.Comments.Page,
+			TotalItems: item.Comments.TotalItems,
+			TotalPages: (item.Comments.TotalItems + pageSize - 1) / pageSize,
+		}
+		if list.TotalPages == 0 {
+			list.TotalPages = 1
+		}
+		for _, cm := range item.Comments.Items {
+			comment := Comment{
+				Username: cm.Username,
+				Value:    decodeHTML(cm.Value),
+			}
+			// Rating is "N/A" for comments without a rating
+			comment.Rating, _ = strconv.ParseFloat(cm.Rating, 64)
+			list.Comments = append(list.Comments, comment)
+		}
+		game.Comments = list
+	}
+}
+
+// convertXMLToPlayerCountPoll converts the suggested_numplayers poll and its
+// summary to a PlayerCountPoll.
+func convertXMLToPlayerCountPoll(poll xmlPoll, summaries []xmlPollSummary) *PlayerCountPoll {
+	pcp := &PlayerCountPoll{TotalVotes: poll.TotalVotes}
+	for _, pr := range poll.Results {
+		var v PlayerCountVotes
+		v.NumPlayers = pr.NumPlayers
+		for _, r := range pr.Results {
+			switch r.Value {
+			case "Best":
+				v.Best = r.NumVotes
+			case "Recommended":
+				v.Recommended = r.NumVotes
+			case "Not Recommended":
+				v.NotRecommended = r.NumVotes
+			}
+		}
+		pcp.Results = append(pcp.Results, v)
+	}
+	// Extract poll-summary data
+	for _, ps := range summaries {
+		if ps.Name != "suggested_numplayers" {
 			continue
 		}
-		pcp := &PlayerCountPoll{TotalVotes: poll.TotalVotes}
-		for _, pr := range poll.Results {
-			var v PlayerCountVotes
-			v.NumPlayers = pr.NumPlayers
-			for _, r := range pr.Results {
-				switch r.Value {
-				case "Best":
-					v.Best = r.NumVotes
-				case "Recommended":
-					v.Recommended = r.NumVotes
-				case "Not Recommended":
-					v.NotRecommended = r.NumVotes
-				}
+		for _, r := range ps.Results {
+			switch r.Name {
+			case "bestwith":
+				pcp.BestWith = r.Value
+			case "recommmendedwith":
+				pcp.RecWith = r.Value
 			}
-			pcp.Results = append(pcp.Results, v)
 		}
-		// Extract poll-summary data
-		for _, ps := range item.PollSummaries {
-			if ps.Name != "suggested_numplayers" {
-				continue
+	}
+	return pcp
+}
+
+// convertXMLToAgePoll converts the suggested_playerage poll to an AgePoll.
+// The winner is the age with the most votes; ties go to the younger age.
+func convertXMLToAgePoll(poll xmlPoll) *AgePoll {
+	ap := &AgePoll{TotalVotes: poll.TotalVotes}
+	best := 0
+	for _, pr := range poll.Results {
+		for _, r := range pr.Results {
+			ap.Results = append(ap.Results, PollVote{Value: r.Value, NumVotes: r.NumVotes})
+			if r.NumVotes > best {
+				best = r.NumVotes
+				ap.Winner = r.Value
 			}
-			for _, r := range ps.Results {
-				switch r.Name {
-				case "bestwith":
-					pcp.BestWith = r.Value
-				case "recommmendedwith":
-					pcp.RecWith = r.Value
-				}
+		}
+	}
+	return ap
+}
+
+// convertXMLToLanguageDependencePoll converts the language_dependence poll to a
+// LanguageDependencePoll. The winner is the level with the most votes; ties go
+// to the lower level.
+func convertXMLToLanguageDependencePoll(poll xmlPoll) *LanguageDependencePoll {
+	lp := &LanguageDependencePoll{TotalVotes: poll.TotalVotes}
+	best := 0
+	for _, pr := range poll.Results {
+		for _, r := range pr.Results {
+			lp.Results = append(lp.Results, LanguageDependenceVotes{
+				Level:       r.Level,
+				Description: r.Value,
+				NumVotes:    r.NumVotes,
+			})
+			if r.NumVotes > best {
+				best = r.NumVotes
+				lp.Winner = r.Value
+				lp.WinnerLevel = r.Level
 			}
 		}
-		game.PlayerCountPoll = pcp
-		break
 	}
+	return lp
+}
 
-	return game
+// convertXMLToVersion converts an XML version item to a Version struct.
+func convertXMLToVersion(v xmlVersion) Version {
+	version := Version{
+		ID:          v.ID,
+		Year:        v.YearValue.Value,
+		Thumbnail:   v.Thumbnail,
+		Image:       v.Image,
+		ProductCode: v.ProductCode.Value,
+	}
+	for _, name := range v.Names {
+		if name.Type == "primary" {
+			version.Name = name.Value
+			break
+		}
+	}
+	version.Width, _ = strconv.ParseFloat(v.Width.Value, 64)
+	version.Length, _ = strconv.ParseFloat(v.Length.Value, 64)
+	version.Depth, _ = strconv.ParseFloat(v.Depth.Value, 64)
+	version.Weight, _ = strconv.ParseFloat(v.Weight.Value, 64)
+	for _, link := range v.Links {
+		switch link.Type {
+		case "boardgamepublisher":
+			version.Publishers = append(version.Publishers, link.Value)
+		case "boardgameartist":
+			version.Artists = append(version.Artists, link.Value)
+		case "language":
+			version.Languages = append(version.Languages, link.Value)
+		}
+	}
+	return version
 }
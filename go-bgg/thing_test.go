@@ -51,9 +51,15 @@ func TestGetGame(t *testing.T) {
 	if game.Name != "CATAN" {
 		t.Errorf("expected name 'CATAN', got '%s'", game.Name)
 	}
+	if game.Type != ThingBoardGame {
+		t.Errorf("expected type 'boardgame', got '%s'", game.Type)
+	}
 	if game.Year != "1995" {
 		t.Errorf("expected year '1995', got '%s'", game.Year)
 	}
+	if len(game.AlternateNames) != 1 || game.AlternateNames[0] != "Catan: Das Spiel" {
+		t.Errorf("expected AlternateNames [Catan: Das Spiel], got %v", game.AlternateNames)
+	}
 
 	// Verify player count
 	if game.MinPlayers != 3 {
@@ -89,6 +95,15 @@ func TestGetGame(t *testing.T) {
 	if game.Rank != 389 {
 		t.Errorf("expected Rank 389, got %d", game.Rank)
 	}
+	if len(game.Ranks) != 2 {
+		t.Fatalf("expected 2 ranks, got %d", len(game.Ranks))
+	}
+	if r := game.Ranks[0]; r.Domain != "boardgame" || r.FriendlyName != "Board Game Rank" || r.Value != 389 || r.Bayes != 7.01234 {
+		t.Errorf("unexpected overall rank: %+v", r)
+	}
+	if r := game.Ranks[1]; r.Domain != "strategygames" || r.Value != 412 || r.Bayes != 6.98765 {
+		t.Errorf("unexpected strategy rank: %+v", r)
+	}
 	if game.Weight < 2.31 || game.Weight > 2.33 {
 		t.Errorf("expected Weight ~2.32, got %f", game.Weight)
 	}
@@ -138,6 +153,44 @@ func TestGetGame(t *testing.T) {
 		t.Errorf("expected 4 mechanics, got %d", len(game.Mechanics))
 	}
 
+	// Verify families
+	if len(game.Families) != 2 || game.Families[0] != "Series: Catan" {
+		t.Errorf("expected families [Series: Catan ...], got %v", game.Families)
+	}
+
+	// Verify typed links
+	if len(game.Links) != 19 {
+		t.Errorf("expected 19 links in total, got %d", len(game.Links))
+	}
+	if len(game.DesignerLinks) != 1 {
+		t.Fatalf("expected 1 designer link, got %d", len(game.DesignerLinks))
+	}
+	if dl := game.DesignerLinks[0]; dl.ID != 11 || dl.Type != LinkDesigner || dl.Name != "Klaus Teuber" || dl.Inbound {
+		t.Errorf("unexpected designer link: %+v", dl)
+	}
+	if len(game.ArtistLinks) != 2 || len(game.PublisherLinks) != 2 || len(game.CategoryLinks) != 2 || len(game.MechanicLinks) != 4 {
+		t.Errorf("expected typed links to match string fields, got artists %d, publishers %d, categories %d, mechanics %d",
+			len(game.ArtistLinks), len(game.PublisherLinks), len(game.CategoryLinks), len(game.MechanicLinks))
+	}
+	if len(game.FamilyLinks) != 2 || game.FamilyLinks[0].ID != 3 {
+		t.Errorf("expected family link with ID 3, got %v", game.FamilyLinks)
+	}
+	if len(game.Expansions) != 2 || game.Expansions[0].ID != 926 || game.Expansions[0].Name != "CATAN: Cities & Knights" {
+		t.Errorf("unexpected expansions: %v", game.Expansions)
+	}
+	if len(game.Accessories) != 1 || game.Accessories[0].ID != 93232 {
+		t.Errorf("unexpected accessories: %v", game.Accessories)
+	}
+	if len(game.Implementations) != 1 || !game.Implementations[0].Inbound {
+		t.Errorf("expected 1 inbound implementation, got %v", game.Implementations)
+	}
+	if len(game.Integrations) != 1 || game.Integrations[0].Inbound {
+		t.Errorf("expected 1 outbound integration, got %v", game.Integrations)
+	}
+	if len(game.Compilations) != 1 || game.Compilations[0].Type != LinkCompilation {
+		t.Errorf("unexpected compilations: %v", game.Compilations)
+	}
+
 	// Verify images
 	if game.Thumbnail == "" {
 		t.Error("expected non-empty Thumbnail")
@@ -178,6 +231,65 @@ func TestGetGame(t *testing.T) {
 	if pcp.RecWith != "Recommended with 3-4 players" {
 		t.Errorf("expected RecWith 'Recommended with 3-4 players', got '%s'", pcp.RecWith)
 	}
+
+	// Verify suggested_playerage poll
+	if game.AgePoll == nil {
+		t.Fatal("expected AgePoll to be non-nil")
+	}
+	if game.AgePoll.TotalVotes != 512 {
+		t.Errorf("expected AgePoll TotalVotes 512, got %d", game.AgePoll.TotalVotes)
+	}
+	if len(game.AgePoll.Results) != 12 {
+		t.Errorf("expected 12 age results, got %d", len(game.AgePoll.Results))
+	}
+	if game.AgePoll.Winner != "10" {
+		t.Errorf("expected AgePoll Winner '10', got '%s'", game.AgePoll.Winner)
+	}
+
+	// Verify language_dependence poll
+	ldp := game.LanguageDependencePoll
+	if ldp == nil {
+		t.Fatal("expected LanguageDependencePoll to be non-nil")
+	}
+	if len(ldp.Results) != 5 {
+		t.Fatalf("expected 5 language results, got %d", len(ldp.Results))
+	}
+	if ldp.Results[4].Level != 5 || ldp.Results[4].Description != "Unplayable in another language" {
+		t.Errorf("unexpected last language result: %+v", ldp.Results[4])
+	}
+	if ldp.WinnerLevel != 2 {
+		t.Errorf("expected WinnerLevel 2, got %d", ldp.WinnerLevel)
+	}
+	if ldp.Winner != "Some necessary text - easily memorized or small crib sheet" {
+		t.Errorf("unexpected Winner: '%s'", ldp.Winner)
+	}
+
+	// Verify poll summaries
+	if len(game.PollSummaries) != 1 {
+		t.Fatalf("expected 1 poll summary, got %d", len(game.PollSummaries))
+	}
+	ps := game.PollSummaries[0]
+	if ps.Name != "suggested_numplayers" || ps.Title != "User Suggested Number of Players" {
+		t.Errorf("unexpected poll summary: %+v", ps)
+	}
+	if ps.Results["bestwith"] != "Best with 4 players" {
+		t.Errorf("expected bestwith 'Best with 4 players', got '%s'", ps.Results["bestwith"])
+	}
+}
+
+func TestConvertXMLToAgePoll_NoVotes(t *testing.T) {
+	poll := xmlPoll{
+		Name:    "suggested_playerage",
+		Results: []xmlPollResults{{Results: []xmlPollResult{{Value: "8"}, {Value: "10"}}}},
+	}
+
+	ap := convertXMLToAgePoll(poll)
+	if ap.Winner != "" {
+		t.Errorf("expected empty Winner, got '%s'", ap.Winner)
+	}
+	if len(ap.Results) != 2 {
+		t.Errorf("expected 2 results, got %d", len(ap.Results))
+	}
 }
 
 func TestGetGame_InvalidID(t *testing.T) {
@@ -216,6 +328,270 @@ func TestGetGame_NotFound(t *testing.T) {
 	}
 }
 
+func TestGetGameWithOptions(t *testing.T) {
+	testData, err := os.ReadFile("testdata/thing_options_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		want := map[string]string{
+			"id":          "13",
+			"stats":       "1",
+			"versions":    "1",
+			"videos":      "1",
+			"marketplace": "1",
+			"comments":    "1",
+			"page":        "2",
+			"pagesize":    "50",
+		}
+		for k, v := range want {
+			if got := q.Get(k); got != v {
+				t.Errorf("expected %s '%s', got '%s'", k, v, got)
+			}
+		}
+		if q.Has("ratingcomments") {
+			t.Error("expected no ratingcomments parameter")
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	game, err := client.GetGameWithOptions(13, GameOptions{
+		Versions:    true,
+		Videos:      true,
+		Marketplace: true,
+		Comments:    true,
+		Page:        2,
+		PageSize:    50,
+	})
+	if err != nil {
+		t.Fatalf("GetGameWithOptions failed: %v", err)
+	}
+
+	if game.Name != "CATAN" {
+		t.Errorf("expected Name 'CATAN', got '%s'", game.Name)
+	}
+
+	// Verify versions
+	if len(game.Versions) != 2 {
+		t.Fatalf("expected 2 versions, got %d", len(game.Versions))
+	}
+	v := game.Versions[0]
+	if v.ID != 38296 {
+		t.Errorf("expected version ID 38296, got %d", v.ID)
+	}
+	if v.Name != "German first edition" {
+		t.Errorf("expected version Name 'German first edition', got '%s'", v.Name)
+	}
+	if v.Year != "1995" {
+		t.Errorf("expected version Year '1995', got '%s'", v.Year)
+	}
+	if v.ProductCode != "684013" {
+		t.Errorf("expected ProductCode '684013', got '%s'", v.ProductCode)
+	}
+	if v.Width != 11.6142 || v.Depth != 2.75591 || v.Weight != 2.2 {
+		t.Errorf("unexpected dimensions: %+v", v)
+	}
+	if len(v.Publishers) != 1 || v.Publishers[0] != "KOSMOS" {
+		t.Errorf("expected Publishers [KOSMOS], got %v", v.Publishers)
+	}
+	if len(v.Artists) != 1 || v.Artists[0] != "Tanja Donner" {
+		t.Errorf("expected Artists [Tanja Donner], got %v", v.Artists)
+	}
+	if len(v.Languages) != 1 || v.Languages[0] != "German" {
+		t.Errorf("expected Languages [German], got %v", v.Languages)
+	}
+
+	// Verify videos
+	if len(game.Videos) != 2 {
+		t.Fatalf("expected 2 videos, got %d", len(game.Videos))
+	}
+	video := game.Videos[0]
+	if video.ID != 1001 || video.Title != "How to play CATAN" {
+		t.Errorf("unexpected first video: %+v", video)
+	}
+	if video.Category != "instructional" {
+		t.Errorf("expected Category 'instructional', got '%s'", video.Category)
+	}
+	if video.Link != "http://www.youtube.com/watch?v=abc123" {
+		t.Errorf("unexpected Link: '%s'", video.Link)
+	}
+	if video.UserID != 111 {
+		t.Errorf("expected UserID 111, got %d", video.UserID)
+	}
+
+	// Verify marketplace listings
+	if len(game.MarketplaceListings) != 1 {
+		t.Fatalf("expected 1 listing, got %d", len(game.MarketplaceListings))
+	}
+	listing := game.MarketplaceListings[0]
+	if listing.Price != 25 || listing.Currency != "USD" {
+		t.Errorf("expected price 25 USD, got %v %s", listing.Price, listing.Currency)
+	}
+	if listing.Condition != "likenew" {
+		t.Errorf("expected Condition 'likenew', got '%s'", listing.Condition)
+	}
+	if listing.Notes != "Played once & sleeved" {
+		t.Errorf("unexpected Notes: '%s'", listing.Notes)
+	}
+	if listing.Link != "https://boardgamegeek.com/market/product/123456" {
+		t.Errorf("unexpected Link: '%s'", listing.Link)
+	}
+
+	// Verify comments
+	if game.Comments == nil {
+		t.Fatal("expected Comments to be non-nil")
+	}
+	if game.Comments.Page != 2 {
+		t.Errorf("expected comments Page 2, got %d", game.Comments.Page)
+	}
+	if game.Comments.TotalItems != 230 {
+		t.Errorf("expected TotalItems 230, got %d", game.Comments.TotalItems)
+	}
+	if game.Comments.TotalPages != 5 {
+		t.Errorf("expected TotalPages 5, got %d", game.Comments.TotalPages)
+	}
+	if len(game.Comments.Comments) != 2 {
+		t.Fatalf("expected 2 comments, got %d", len(game.Comments.Comments))
+	}
+	if c := game.Comments.Comments[0]; c.Username != "player1" || c.Rating != 8 || c.Value != "A classic & still fun." {
+		t.Errorf("unexpected first comment: %+v", c)
+	}
+	if c := game.Comments.Comments[1]; c.Rating != 0 {
+		t.Errorf("expected Rating 0 for N/A, got %f", c.Rating)
+	}
+}
+
+func TestGetGame_NoOptionalSections(t *testing.T) {
+	testData, err := os.ReadFile("testdata/thing_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, k := range []string{"versions", "videos", "marketplace", "comments", "ratingcomments", "page", "pagesize"} {
+			if r.URL.Query().Has(k) {
+				t.Errorf("expected no %s parameter", k)
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	game, err := client.GetGame(13)
+	if err != nil {
+		t.Fatalf("GetGame failed: %v", err)
+	}
+	if game.Versions != nil || game.Videos != nil || game.MarketplaceListings != nil || game.Comments != nil {
+		t.Error("expected no optional sections")
+	}
+}
+
+func TestGetThings(t *testing.T) {
+	testData, err := os.ReadFile("testdata/thing_rpg_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("id") != "54321,98765" {
+			t.Errorf("expected id '54321,98765', got '%s'", q.Get("id"))
+		}
+		if q.Get("type") != "rpgitem,videogame" {
+			t.Errorf("expected type 'rpgitem,videogame', got '%s'", q.Get("type"))
+		}
+		if q.Has("stats") {
+			t.Error("expected no stats parameter")
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	things, err := client.GetThings([]int{54321, 98765}, []ThingType{ThingRPGItem, ThingVideoGame})
+	if err != nil {
+		t.Fatalf("GetThings failed: %v", err)
+	}
+
+	if len(things) != 2 {
+		t.Fatalf("expected 2 things, got %d", len(things))
+	}
+
+	rpg := things[0]
+	if rpg.ID != 54321 || rpg.Type != ThingRPGItem {
+		t.Errorf("unexpected rpg item: %d %s", rpg.ID, rpg.Type)
+	}
+	if rpg.Name != "Call of Cthulhu (1st Edition)" {
+		t.Errorf("unexpected Name: '%s'", rpg.Name)
+	}
+	if len(rpg.AlternateNames) != 1 || rpg.AlternateNames[0] != "L'Appel de Cthulhu" {
+		t.Errorf("unexpected AlternateNames: %v", rpg.AlternateNames)
+	}
+	if rpg.Year != "1981" {
+		t.Errorf("expected Year '1981', got '%s'", rpg.Year)
+	}
+	if rpg.Description != "Horror roleplaying in the worlds of H.P. Lovecraft.\nBoxed set." {
+		t.Errorf("unexpected Description: '%s'", rpg.Description)
+	}
+	if len(rpg.Links) != 3 {
+		t.Fatalf("expected 3 links, got %d", len(rpg.Links))
+	}
+	if l := rpg.Links[1]; l.ID != 2002 || l.Type != "rpgpublisher" || l.Name != "Chaosium" {
+		t.Errorf("unexpected publisher link: %+v", l)
+	}
+	if !rpg.Links[2].Inbound {
+		t.Error("expected rpg link to be inbound")
+	}
+
+	if things[1].Type != ThingVideoGame || things[1].Name != "Catan Universe" {
+		t.Errorf("unexpected video game: %+v", things[1])
+	}
+}
+
+func TestGetThings_Empty(t *testing.T) {
+	client, _ := NewClient(Config{Token: "test-token"})
+
+	things, err := client.GetThings([]int{}, nil)
+	if err != nil {
+		t.Fatalf("GetThings failed: %v", err)
+	}
+	if len(things) != 0 {
+		t.Errorf("expected 0 things, got %d", len(things))
+	}
+}
+
+func TestGetThings_TooMany(t *testing.T) {
+	client, _ := NewClient(Config{Token: "test-token"})
+
+	ids := make([]int, 21)
+	for i := range ids {
+		ids[i] = i + 1
+	}
+
+	_, err := client.GetThings(ids, nil)
+	if err == nil {
+		t.Error("expected error for too many IDs")
+	}
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Errorf("expected ParseError, got %T", err)
+	}
+}
+
 func TestGetGameJSON(t *testing.T) {
 	testData, err := os.ReadFile("testdata/thing_response.xml")
 	if err != nil {
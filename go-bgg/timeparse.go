@@ -0,0 +1,39 @@
+package bgg
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timeLayouts are the date formats found in BGG responses.
+var timeLayouts = []string{
+	time.RFC1123Z,                    // forums, GeekLists, marketplace: "Mon, 02 Jan 2006 15:04:05 -0700"
+	"Mon, 2 Jan 2006 15:04:05 -0700", // same with a single-digit day
+	time.RFC1123,                     // "Mon, 02 Jan 2006 15:04:05 MST"
+	time.RFC3339,                     // threads, videos: "2006-01-02T15:04:05-07:00"
+	"2006-01-02 15:04:05",            // collection lastmodified
+	"2006-01-02",                     // plays
+}
+
+// ParseTime parses a date in any of the formats used by the BGG XML APIs.
+// Dates without a zone are returned in UTC. An empty string and BGG's
+// "0000-00-00" placeholders yield the zero time without an error.
+func ParseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.HasPrefix(s, "0000-00-00") {
+		return time.Time{}, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, newParseError(fmt.Sprintf("unrecognized date %q", s), nil)
+}
+
+// parseTime is like ParseTime but returns the zero time for unrecognized dates.
+func parseTime(s string) time.Time {
+	t, _ := ParseTime(s)
+	return t
+}
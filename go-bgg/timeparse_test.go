@@ -0,0 +1,64 @@
+package bgg
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  time.Time
+	}{
+		{"RFC 2822", "Tue, 10 Feb 2025 14:30:00 +0000", time.Date(2025, 2, 10, 14, 30, 0, 0, time.UTC)},
+		{"RFC 2822 with offset", "Fri, 20 Dec 2024 08:32:00 -0600", time.Date(2024, 12, 20, 14, 32, 0, 0, time.UTC)},
+		{"RFC 2822 single-digit day", "Sat, 1 Jun 2024 09:00:00 +0000", time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)},
+		{"RFC 1123 with zone name", "Mon, 02 Jan 2006 15:04:05 UTC", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"RFC 3339", "2024-12-20T08:32:00-06:00", time.Date(2024, 12, 20, 14, 32, 0, 0, time.UTC)},
+		{"RFC 3339 UTC", "2025-01-15T00:00:00Z", time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{"collection lastmodified", "2024-11-03 08:15:42", time.Date(2024, 11, 3, 8, 15, 42, 0, time.UTC)},
+		{"play date", "2025-01-05", time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"guild created", "Sun, 05 Aug 2007 01:04:08 +0000", time.Date(2007, 8, 5, 1, 4, 8, 0, time.UTC)},
+		{"user lastlogin", "2025-01-14", time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC)},
+		{"surrounding whitespace", " 2025-01-05\n", time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"empty", "", time.Time{}},
+		{"placeholder date", "0000-00-00", time.Time{}},
+		{"placeholder datetime", "0000-00-00 00:00:00", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTime(tt.input)
+			if err != nil {
+				t.Fatalf("ParseTime(%q) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseTime(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTime_KeepsOffset(t *testing.T) {
+	got, _ := ParseTime("Fri, 20 Dec 2024 08:32:00 -0600")
+	if got.Hour() != 8 {
+		t.Errorf("expected the original offset to be kept, got %v", got)
+	}
+}
+
+func TestParseTime_Invalid(t *testing.T) {
+	got, err := ParseTime("unknown")
+	if !got.IsZero() {
+		t.Errorf("expected zero time, got %v", got)
+	}
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Errorf("expected ParseError, got %T", err)
+	}
+
+	if got := parseTime("unknown"); !got.IsZero() {
+		t.Errorf("parseTime() = %v, want zero time", got)
+	}
+}
@@ -0,0 +1,133 @@
+package bgg
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// GetUser retrieves a user's profile, optionally including buddies, guilds
+// and hot/top lists.
+func (c *Client) GetUser(name string, opts UserOptions) (*User, error) {
+	return c.GetUserContext(context.Background(), name, opts)
+}
+
+// GetUserContext is like GetUser but uses ctx for the request.
+func (c *Client) GetUserContext(ctx context.Context, name string, opts UserOptions) (*User, error) {
+	if name == "" {
+		return nil, newParseError("username is required", nil)
+	}
+
+	params := url.Values{}
+	params.Set("name", name)
+	for _, f := range []struct {
+		flag bool
+		key  string
+	}{
+		{opts.Buddies, "buddies"},
+		{opts.Guilds, "guilds"},
+		{opts.Hot, "hot"},
+		{opts.Top, "top"},
+	} {
+		if f.flag {
+			params.Set(f.key, "1")
+		}
+	}
+	if opts.Domain != "" {
+		params.Set("domain", opts.Domain)
+	}
+	if opts.Page > 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+
+	endpoint := fmt.Sprintf("/user?%s", params.Encode())
+	body, err := c.doRequest(ctx, endpoint)
+	if err != nil {
+		return nil, withUsername(err, name)
+	}
+
+	xmlResp, err := parseXML[xmlUser](body, "failed to parse user response")
+	if err != nil {
+		return nil, err
+	}
+
+	// BGG answers unknown users with an empty <user id=""> element
+	id, err := strconv.Atoi(xmlResp.ID)
+	if err != nil || id == 0 {
+		return nil, &InvalidUsernameError{Username: name, Message: "Invalid username specified"}
+	}
+
+	user := convertXMLToUser(*xmlResp)
+	return &user, nil
+}
+
+// GetUserJSON retrieves a user's profile and returns JSON.
+func (c *Client) GetUserJSON(name string, opts UserOptions) (string, error) {
+	return c.GetUserJSONContext(context.Background(), name, opts)
+}
+
+// GetUserJSONContext is like GetUserJSON but uses ctx for the request.
+func (c *Client) GetUserJSONContext(ctx context.Context, name string, opts UserOptions) (string, error) {
+	user, err := c.GetUserContext(ctx, name, opts)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(user)
+}
+
+// convertXMLToUser converts an XML user to a User struct.
+func convertXMLToUser(u xmlUser) User {
+	id, _ := strconv.Atoi(u.ID)
+	user := User{
+		ID:              id,
+		Name:            u.Name,
+		FirstName:       u.FirstName.Value,
+		LastName:        u.LastName.Value,
+		LastLogin:       u.LastLogin.Value,
+		LastLoginTime:   parseTime(u.LastLogin.Value),
+		StateOrProvince: u.StateOrProvince.Value,
+		Country:         u.Country.Value,
+		WebAddress:      u.WebAddress.Value,
+		TotalBuddies:    u.Buddies.Total,
+		TotalGuilds:     u.Guilds.Total,
+		Page:            max(u.Buddies.Page, u.Guilds.Page),
+	}
+
+	// BGG uses "N/A" for users without an avatar
+	if u.AvatarLink.Value != "N/A" {
+		user.Avatar = u.AvatarLink.Value
+	}
+	user.YearRegistered, _ = strconv.Atoi(u.YearRegistered.Value)
+	user.TradeRating, _ = strconv.Atoi(u.TradeRating.Value)
+
+	for _, b := range u.Buddies.Items {
+		user.Buddies = append(user.Buddies, UserBuddy{ID: b.ID, Name: b.Name})
+	}
+	for _, g := range u.Guilds.Items {
+		user.Guilds = append(user.Guilds, UserGuild{ID: g.ID, Name: g.Name})
+	}
+
+	user.Hot = convertXMLToUserRankedList(u.Hot)
+	user.Top = convertXMLToUserRankedList(u.Top)
+
+	return user
+}
+
+// convertXMLToUserRankedList converts an XML hot/top list to a UserRankedList.
+// Returns nil if the list was not requested.
+func convertXMLToUserRankedList(l *xmlUserRanked) *UserRankedList {
+	if l == nil {
+		return nil
+	}
+	list := &UserRankedList{Domain: l.Domain}
+	for _, item := range l.Items {
+		list.Items = append(list.Items, UserRankedItem{
+			Rank: item.Rank,
+			ID:   item.ID,
+			Type: item.Type,
+			Name: item.Name,
+		})
+	}
+	return list
+}
@@ -0,0 +1,219 @@
+package bgg
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestGetUser(t *testing.T) {
+	testData, err := os.ReadFile("testdata/user_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Verify request path
+		if r.URL.Path != "/user" {
+			t.Errorf("expected path '/user', got '%s'", r.URL.Path)
+		}
+
+		// Verify query parameters
+		q := r.URL.Query()
+		if q.Get("name") != "testuser" {
+			t.Errorf("expected name 'testuser', got '%s'", q.Get("name"))
+		}
+		for _, k := range []string{"buddies", "guilds", "hot", "top"} {
+			if q.Get(k) != "1" {
+				t.Errorf("expected %s '1', got '%s'", k, q.Get(k))
+			}
+		}
+		if q.Get("domain") != "boardgame" {
+			t.Errorf("expected domain 'boardgame', got '%s'", q.Get("domain"))
+		}
+		if q.Get("page") != "2" {
+			t.Errorf("expected page '2', got '%s'", q.Get("page"))
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	user, err := client.GetUser("testuser", UserOptions{
+		Buddies: true,
+		Guilds:  true,
+		Hot:     true,
+		Top:     true,
+		Domain:  "boardgame",
+		Page:    2,
+	})
+	if err != nil {
+		t.Fatalf("GetUser failed: %v", err)
+	}
+
+	// Verify profile fields
+	if user.ID != 123456 {
+		t.Errorf("expected ID 123456, got %d", user.ID)
+	}
+	if user.Name != "testuser" {
+		t.Errorf("expected Name 'testuser', got '%s'", user.Name)
+	}
+	if user.FirstName != "Test" || user.LastName != "User" {
+		t.Errorf("expected name 'Test User', got '%s %s'", user.FirstName, user.LastName)
+	}
+	if user.Avatar == "" {
+		t.Error("expected non-empty Avatar")
+	}
+	if user.YearRegistered != 2008 {
+		t.Errorf("expected YearRegistered 2008, got %d", user.YearRegistered)
+	}
+	if user.LastLogin != "2025-01-14" {
+		t.Errorf("expected LastLogin '2025-01-14', got '%s'", user.LastLogin)
+	}
+	if want := time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC); !user.LastLoginTime.Equal(want) {
+		t.Errorf("expected LastLoginTime %v, got %v", want, user.LastLoginTime)
+	}
+	if user.Country != "Japan" {
+		t.Errorf("expected Country 'Japan', got '%s'", user.Country)
+	}
+	if user.TradeRating != 12 {
+		t.Errorf("expected TradeRating 12, got %d", user.TradeRating)
+	}
+
+	// Verify buddies and guilds
+	if user.TotalBuddies != 2 || len(user.Buddies) != 2 {
+		t.Errorf("expected 2 buddies, got %d (total %d)", len(user.Buddies), user.TotalBuddies)
+	} else if user.Buddies[0].ID != 234567 || user.Buddies[0].Name != "friend1" {
+		t.Errorf("unexpected first buddy: %+v", user.Buddies[0])
+	}
+	if user.TotalGuilds != 1 || len(user.Guilds) != 1 {
+		t.Errorf("expected 1 guild, got %d (total %d)", len(user.Guilds), user.TotalGuilds)
+	} else if user.Guilds[0].Name != "Tokyo Board Gamers" {
+		t.Errorf("expected guild 'Tokyo Board Gamers', got '%s'", user.Guilds[0].Name)
+	}
+
+	// Verify ranked lists
+	if user.Top == nil {
+		t.Fatal("expected Top to be non-nil")
+	}
+	if user.Top.Domain != "boardgame" {
+		t.Errorf("expected Top domain 'boardgame', got '%s'", user.Top.Domain)
+	}
+	if len(user.Top.Items) != 2 {
+		t.Fatalf("expected 2 top items, got %d", len(user.Top.Items))
+	}
+	if user.Top.Items[0].Rank != 1 || user.Top.Items[0].ID != 224517 || user.Top.Items[0].Name != "Brass: Birmingham" {
+		t.Errorf("unexpected first top item: %+v", user.Top.Items[0])
+	}
+	if user.Hot == nil || len(user.Hot.Items) != 1 {
+		t.Fatalf("expected 1 hot item, got %+v", user.Hot)
+	}
+	if user.Hot.Items[0].Type != "thing" {
+		t.Errorf("expected hot item type 'thing', got '%s'", user.Hot.Items[0].Type)
+	}
+}
+
+func TestGetUser_NoOptionalSections(t *testing.T) {
+	response := `<user id="123456" name="testuser"><avatarlink value="N/A"/><yearregistered value="2008"/></user>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, k := range []string{"buddies", "guilds", "hot", "top", "domain", "page"} {
+			if r.URL.Query().Has(k) {
+				t.Errorf("expected no %s parameter", k)
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	user, err := client.GetUser("testuser", UserOptions{})
+	if err != nil {
+		t.Fatalf("GetUser failed: %v", err)
+	}
+	if user.Avatar != "" {
+		t.Errorf("expected empty Avatar for N/A, got '%s'", user.Avatar)
+	}
+	if user.Hot != nil || user.Top != nil {
+		t.Error("expected Hot and Top to be nil")
+	}
+	if len(user.Buddies) != 0 || len(user.Guilds) != 0 {
+		t.Error("expected no buddies or guilds")
+	}
+}
+
+func TestGetUser_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<user id="" name="nobody"><firstname value=""/></user>`))
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	_, err := client.GetUser("nobody", UserOptions{})
+	if err == nil {
+		t.Fatal("expected error for unknown user")
+	}
+
+	var invalid *InvalidUsernameError
+	if !errors.As(err, &invalid) {
+		t.Fatalf("expected InvalidUsernameError, got %T", err)
+	}
+	if invalid.Username != "nobody" {
+		t.Errorf("expected Username 'nobody', got %q", invalid.Username)
+	}
+}
+
+func TestGetUser_EmptyName(t *testing.T) {
+	client, _ := NewClient(Config{Token: "test-token"})
+
+	_, err := client.GetUser("", UserOptions{})
+	if err == nil {
+		t.Error("expected error for empty username")
+	}
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Errorf("expected ParseError, got %T", err)
+	}
+}
+
+func TestGetUserJSON(t *testing.T) {
+	testData, err := os.ReadFile("testdata/user_response.xml")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write(testData)
+	}))
+	defer server.Close()
+
+	client := createTestClient(t, server)
+
+	jsonStr, err := client.GetUserJSON("testuser", UserOptions{})
+	if err != nil {
+		t.Fatalf("GetUserJSON failed: %v", err)
+	}
+
+	// Verify it's valid JSON
+	var user User
+	if err := json.Unmarshal([]byte(jsonStr), &user); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+
+	if user.ID != 123456 {
+		t.Errorf("expected ID 123456, got %d", user.ID)
+	}
+}
@@ -18,7 +18,12 @@ type Config struct {
 
 // APIConfig contains API-related configuration.
 type APIConfig struct {
-	Token string `toml:"token"`
+	Token     string `toml:"token"`
+	BaseURL   string `toml:"base_url,omitempty"`   // XML API v2 base URL (e.g. a mirror or mock server)
+	UserAgent string `toml:"user_agent,omitempty"` // User-Agent sent to BGG (default: bgg-tui/<version>)
+	Proxy     string `toml:"proxy,omitempty"`      // HTTP proxy URL (default: from environment)
+	RecordDir string `toml:"record_dir,omitempty"` // Save API responses here for bug reports
+	ReplayDir string `toml:"replay_dir,omitempty"` // Serve API responses recorded here instead of calling BGG
 }
 
 // DisplayConfig contains display-related configuration.
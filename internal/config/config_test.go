@@ -70,6 +70,9 @@ func TestSaveAndLoad(t *testing.T) {
 	cfg.API.Token = "test-token-123"
 	cfg.Display.ShowImages = false
 	cfg.Collection.DefaultUsername = "testuser"
+	cfg.API.BaseURL = "http://localhost:8080/xmlapi2"
+	cfg.API.UserAgent = "my-agent/1.0"
+	cfg.API.Proxy = "http://proxy.example.com:3128"
 	if err := cfg.SaveToPath(path); err != nil {
 		t.Fatalf("failed to save config: %v", err)
 	}
@@ -96,6 +99,10 @@ func TestSaveAndLoad(t *testing.T) {
 	if loaded.Collection.DefaultUsername != "testuser" {
 		t.Errorf("expected DefaultUsername 'testuser', got '%s'", loaded.Collection.DefaultUsername)
 	}
+
+	if loaded.API.BaseURL != "http://localhost:8080/xmlapi2" || loaded.API.UserAgent != "my-agent/1.0" || loaded.API.Proxy != "http://proxy.example.com:3128" {
+		t.Errorf("unexpected API config: %+v", loaded.API)
+	}
 }
 
 func TestHasToken(t *testing.T) {
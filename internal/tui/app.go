@@ -1,7 +1,12 @@
 package tui
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
@@ -58,17 +63,24 @@ type Model struct {
 	selectionType  string
 }
 
-// New creates a new application model.
-func New(cfg *config.Config) Model {
+// New creates a new application model. It fails if the [api] section of
+// cfg is invalid.
+func New(cfg *config.Config) (Model, error) {
 	styles := NewStyles(cfg.Interface.ColorTheme)
 	keys := DefaultKeyMap()
 
+	// Validate up front, as the client may only be created after setup
+	if _, err := newHTTPClient(cfg); err != nil {
+		return Model{}, err
+	}
+
 	// Create BGG client if token is available
 	var client *bgg.Client
 	if cfg.API.Token != "" {
-		client, _ = bgg.NewClient(bgg.Config{
-			Token: cfg.API.Token,
-		})
+		var err error
+		if client, err = newBGGClient(cfg); err != nil {
+			return Model{}, err
+		}
 	}
 
 	// Initialize image support
@@ -105,7 +117,99 @@ func New(cfg *config.Config) Model {
 		imageCache:     imgCache,
 		transitionType: cfg.Interface.Transition,
 		selectionType:  cfg.Interface.Selection,
+	}, nil
+}
+
+// newBGGClient creates a BGG client from the [api] config section. Hot
+// lists and game details are cached on disk next to the image cache, falling
+// back to an in-memory cache. Collections, forums and threads are always
+// fetched fresh, as the views have no way to refresh them. The cache is off
+// while recording or replaying, so that every request goes through the
+// cassette.
+func newBGGClient(cfg *config.Config) (*bgg.Client, error) {
+	var cache bgg.Cache
+	if cfg.API.RecordDir == "" && cfg.API.ReplayDir == "" {
+		cache = bgg.NewMemoryCache()
+		if cacheDir, err := os.UserCacheDir(); err == nil {
+			if fc, err := bgg.NewFileCache(filepath.Join(cacheDir, "bgg-tui", "api")); err == nil {
+				cache = fc
+			}
+		}
+	}
+
+	userAgent := cfg.API.UserAgent
+	if userAgent == "" {
+		// Version may be empty when built with a blank -X flag
+		version := "dev"
+		if f := strings.Fields(Version); len(f) > 0 {
+			version = f[0]
+		}
+		userAgent = "bgg-tui/" + version
+	}
+
+	httpClient, err := newHTTPClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return bgg.NewClient(bgg.Config{
+		Token:      cfg.API.Token,
+		Cache:      cache,
+		CacheTTLs:  bgg.CacheTTLs{Collection: -1, Default: -1},
+		HTTPClient: httpClient,
+		BaseURL:    cfg.API.BaseURL,
+		UserAgent:  userAgent,
+	})
+}
+
+// newHTTPClient creates the HTTP client for the [api] proxy, record_dir and
+// replay_dir settings, or returns nil if none is set.
+func newHTTPClient(cfg *config.Config) (*http.Client, error) {
+	var transport http.RoundTripper
+	proxyURL, err := parseProxy(cfg.API.Proxy)
+	if err != nil {
+		return nil, err
+	}
+	if proxyURL != nil {
+		t := http.DefaultTransport.(*http.Transport).Clone()
+		t.Proxy = http.ProxyURL(proxyURL)
+		transport = t
+	}
+	switch {
+	case cfg.API.ReplayDir != "":
+		transport = bgg.NewReplayTransport(cfg.API.ReplayDir)
+	case cfg.API.RecordDir != "":
+		// A response that fails to record is still shown; there is nowhere
+		// to print the error while the TUI owns the screen
+		rt, err := bgg.NewRecordingTransport(cfg.API.RecordDir, transport, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid [api] record_dir %q: %w", cfg.API.RecordDir, err)
+		}
+		transport = rt
 	}
+
+	if transport == nil {
+		return nil, nil
+	}
+	return &http.Client{
+		Timeout:   bgg.DefaultTimeout,
+		Transport: transport,
+	}, nil
+}
+
+// parseProxy parses the [api] proxy setting. An empty value means no proxy.
+func parseProxy(s string) (*url.URL, error) {
+	if s == "" {
+		return nil, nil
+	}
+	u, err := url.Parse(s)
+	if err == nil && (u.Scheme == "" || u.Host == "") {
+		err = errors.New("expected a URL like http://host:port")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid [api] proxy %q: %w", s, err)
+	}
+	return u, nil
 }
 
 // Init implements tea.Model.
@@ -193,9 +297,8 @@ func (m Model) updateSetupToken(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if m.setupToken.done {
 		m.setupToken.done = false
 		// Create BGG client with new token
-		m.bggClient, _ = bgg.NewClient(bgg.Config{
-			Token: m.config.API.Token,
-		})
+		// Only the token changed; the rest was validated in New
+		m.bggClient, _ = newBGGClient(m.config)
 		m.menu = newMenuModel(m.config, m.styles, m.keys, true)
 		m.setView(ViewMenu)
 	}
@@ -223,7 +326,7 @@ func (m Model) updateMenu(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case ViewHot:
 			m.setView(ViewHot)
 			m.hot = newHotModel(m.config, m.styles, m.keys, m.imageEnabled, m.imageCache)
-			return m, m.hot.loadHotGames(m.bggClient)
+			return m, m.hot.loadHotGames(m.bggClient, false)
 		case ViewCollectionInput:
 			m.setView(ViewCollectionInput)
 			m.collection = newCollectionModel(m.config, m.styles, m.keys, m.imageEnabled, m.imageCache)
@@ -332,6 +435,7 @@ func (m Model) updateDetail(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	if m.detail.wantsMenu {
 		m.detail.wantsMenu = false
+		m.cancelLoads()
 		m.setView(ViewMenu)
 		if m.imageEnabled {
 			m.needsClearImages = true
@@ -340,6 +444,7 @@ func (m Model) updateDetail(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	if m.detail.wantsBack {
 		m.detail.wantsBack = false
+		m.detail.CancelLoads()
 		m.setView(m.previousView)
 		if m.imageEnabled {
 			m.needsClearImages = true
@@ -378,11 +483,13 @@ func (m Model) updateForum(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	if m.forum.wantsMenu {
 		m.forum.wantsMenu = false
+		m.cancelLoads()
 		m.setView(ViewMenu)
 	}
 
 	if m.forum.wantsBack {
 		m.forum.wantsBack = false
+		m.forum.CancelLoads()
 		m.setView(ViewDetail)
 	}
 
@@ -400,21 +507,34 @@ func (m Model) updateForum(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 func (m Model) updateThread(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
-	m.thread, cmd = m.thread.Update(msg)
+	m.thread, cmd = m.thread.Update(msg, m.bggClient)
 
 	if m.thread.wantsMenu {
 		m.thread.wantsMenu = false
+		m.cancelLoads()
 		m.setView(ViewMenu)
 	}
 
 	if m.thread.wantsBack {
 		m.thread.wantsBack = false
+		m.thread.CancelLoads()
 		m.setView(ViewThreadList)
 	}
 
 	return m, cmd
 }
 
+// cancelLoads aborts the in-flight requests of every sub-model, used when
+// jumping back to the menu from a nested view.
+func (m *Model) cancelLoads() {
+	m.search.CancelLoads()
+	m.hot.CancelLoads()
+	m.collection.CancelLoads()
+	m.detail.CancelLoads()
+	m.forum.CancelLoads()
+	m.thread.CancelLoads()
+}
+
 // setView changes the current view, starting a transition if configured.
 func (m *Model) setView(view View) {
 	if view != m.currentView && m.transitionType != "" && m.transitionType != "none" {
@@ -518,7 +638,7 @@ func (m Model) renderHelpOverlay() string {
 	b.WriteString("\n")
 	pages := []struct{ key, desc string }{
 		{"1", "Trending games on BGG"},
-		{"2/s", "Search board games by name"},
+		{"2/s", "Search games, RPGs, video games"},
 		{"3", "Browse a user's game collection"},
 		{"4", "Configure app preferences"},
 	}
@@ -0,0 +1,218 @@
+package tui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	bgg "github.com/hiroaqii/go-bgg"
+
+	"github.com/hiroaqii/bgg-tui/internal/config"
+)
+
+func TestNewBGGClient_APIConfig(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	var gotUA string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUA = r.Header.Get("User-Agent")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<items><item id="13" rank="1"><name value="CATAN"/></item></items>`))
+	}))
+	defer server.Close()
+
+	cfg := config.DefaultConfig()
+	cfg.API.Token = "test-token"
+	cfg.API.BaseURL = server.URL
+
+	client, err := newBGGClient(cfg)
+	if err != nil {
+		t.Fatalf("newBGGClient() error = %v", err)
+	}
+	games, err := client.GetHotGames()
+	if err != nil {
+		t.Fatalf("GetHotGames() error = %v", err)
+	}
+	if len(games) != 1 || games[0].Name != "CATAN" {
+		t.Errorf("unexpected games from mock server: %+v", games)
+	}
+	if !strings.HasPrefix(gotUA, "bgg-tui/") {
+		t.Errorf("expected default User-Agent 'bgg-tui/...', got %q", gotUA)
+	}
+}
+
+func TestNewBGGClient_Replay(t *testing.T) {
+	dir := t.TempDir()
+	hot := `<items><item id="13" rank="1"><name value="CATAN"/></item></items>`
+	if err := os.WriteFile(filepath.Join(dir, "xmlapi2_hot_type=boardgame.xml"), []byte(hot), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.API.Token = "any"
+	cfg.API.ReplayDir = dir
+
+	client, err := newBGGClient(cfg)
+	if err != nil {
+		t.Fatalf("newBGGClient() error = %v", err)
+	}
+	games, err := client.GetHotGames()
+	if err != nil {
+		t.Fatalf("GetHotGames() error = %v", err)
+	}
+	if len(games) != 1 || games[0].Name != "CATAN" {
+		t.Errorf("unexpected replayed games: %+v", games)
+	}
+}
+
+func TestNewBGGClient_EmptyVersion(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	saved := Version
+	Version = ""
+	defer func() { Version = saved }()
+
+	var gotUA string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUA = r.Header.Get("User-Agent")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<items></items>`))
+	}))
+	defer server.Close()
+
+	cfg := config.DefaultConfig()
+	cfg.API.Token = "test-token"
+	cfg.API.BaseURL = server.URL
+
+	client, err := newBGGClient(cfg)
+	if err != nil {
+		t.Fatalf("newBGGClient() error = %v", err)
+	}
+	if _, err := client.GetHotGames(); err != nil {
+		t.Fatalf("GetHotGames() error = %v", err)
+	}
+	if gotUA != "bgg-tui/dev" {
+		t.Errorf("expected User-Agent 'bgg-tui/dev', got %q", gotUA)
+	}
+}
+
+func TestNew_InvalidProxy(t *testing.T) {
+	for _, proxy := range []string{"localhost:8080", "http://[::1", "not a url"} {
+		cfg := config.DefaultConfig()
+		cfg.API.Proxy = proxy
+
+		// Checked even before a token is configured
+		if _, err := New(cfg); err == nil || !strings.Contains(err.Error(), "proxy") {
+			t.Errorf("New() with proxy %q: expected a proxy error, got %v", proxy, err)
+		}
+
+		cfg.API.Token = "test-token"
+		if _, err := newBGGClient(cfg); err == nil {
+			t.Errorf("newBGGClient() with proxy %q: expected error", proxy)
+		}
+	}
+}
+
+func TestNewBGGClient_Proxy(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	var gotURL string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotURL = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<items></items>`))
+	}))
+	defer proxy.Close()
+
+	cfg := config.DefaultConfig()
+	cfg.API.Token = "test-token"
+	cfg.API.BaseURL = "http://bgg.invalid/xmlapi2"
+	cfg.API.Proxy = proxy.URL
+
+	client, err := newBGGClient(cfg)
+	if err != nil {
+		t.Fatalf("newBGGClient() error = %v", err)
+	}
+	if _, err := client.GetHotGames(); err != nil {
+		t.Fatalf("GetHotGames() error = %v", err)
+	}
+	if !strings.HasPrefix(gotURL, "http://bgg.invalid/xmlapi2/hot") {
+		t.Errorf("expected the request to go through the proxy, got %q", gotURL)
+	}
+}
+
+func TestNewBGGClient_CachedEndpoints(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/forum":
+			w.Write([]byte(`<forum id="21" numthreads="0"><threads></threads></forum>`))
+		case "/collection":
+			w.Write([]byte(`<items totalitems="0"></items>`))
+		default:
+			w.Write([]byte(`<items></items>`))
+		}
+	}))
+	defer server.Close()
+
+	cfg := config.DefaultConfig()
+	cfg.API.Token = "test-token"
+	cfg.API.BaseURL = server.URL
+
+	client, err := newBGGClient(cfg)
+	if err != nil {
+		t.Fatalf("newBGGClient() error = %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetHotGames(); err != nil {
+			t.Fatalf("GetHotGames() error = %v", err)
+		}
+		if _, err := client.GetForumThreads(21, 1); err != nil {
+			t.Fatalf("GetForumThreads() error = %v", err)
+		}
+		if _, err := client.GetCollection("testuser", bgg.CollectionOptions{}); err != nil {
+			t.Fatalf("GetCollection() error = %v", err)
+		}
+	}
+
+	// Reloading a forum or collection must show fresh data
+	if requests["/hot"] != 1 {
+		t.Errorf("expected the hot list to be cached, got %d requests", requests["/hot"])
+	}
+	if requests["/forum"] != 2 {
+		t.Errorf("expected forums not to be cached, got %d requests", requests["/forum"])
+	}
+	if requests["/collection"] != 2 {
+		t.Errorf("expected collections not to be cached, got %d requests", requests["/collection"])
+	}
+}
+
+func TestNew_InvalidRecordDir(t *testing.T) {
+	// A regular file cannot be used as the recording directory
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.API.RecordDir = file
+	if _, err := New(cfg); err == nil || !strings.Contains(err.Error(), "record_dir") {
+		t.Errorf("New() expected a record_dir error, got %v", err)
+	}
+
+	cfg.API.Token = "test-token"
+	if _, err := newBGGClient(cfg); err == nil {
+		t.Error("newBGGClient() expected error")
+	}
+}
@@ -1,8 +1,10 @@
 package tui
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
@@ -34,7 +36,8 @@ type collectionModel struct {
 	config    *config.Config
 	input     textinput.Model
 	errMsg    string
-	selected  *int // Selected game ID for detail view
+	progress  *bgg.CollectionProgress // set while BGG prepares the collection
+	selected  *int                    // Selected game ID for detail view
 	wantsBack bool
 	wantsMenu bool
 
@@ -47,6 +50,8 @@ type collectionModel struct {
 	activeStatuses map[CollectionStatus]bool
 
 	img listImageState
+
+	loadContext
 }
 
 func (m *collectionModel) WantsMenu() bool  { return m.wantsMenu }
@@ -54,6 +59,12 @@ func (m *collectionModel) WantsBack() bool  { return m.wantsBack }
 func (m *collectionModel) Selected() *int   { return m.selected }
 func (m *collectionModel) ClearSignals()    { m.wantsMenu = false; m.wantsBack = false; m.selected = nil }
 
+// collectionProgressMsg is sent while BGG is still preparing the collection.
+type collectionProgressMsg struct {
+	progress bgg.CollectionProgress
+	updates  <-chan tea.Msg
+}
+
 // collectionResultMsg is sent when collection results are received.
 type collectionResultMsg struct {
 	items []bgg.CollectionItem
@@ -88,19 +99,72 @@ func newCollectionModel(cfg *config.Config, styles Styles, keys KeyMap, imageEna
 			getName: func(item bgg.CollectionItem) string { return item.Name },
 			getID:   func(item bgg.CollectionItem) int { return item.ID },
 		},
+
+		loadContext: newLoadContext(),
 	}
 }
 
 func (m collectionModel) loadCollection(client *bgg.Client, username string) tea.Cmd {
+	ctx := m.ctx()
 	return func() tea.Msg {
 		if client == nil {
 			return collectionResultMsg{err: fmt.Errorf(errNoToken)}
 		}
-		items, err := client.GetCollection(username, bgg.CollectionOptions{})
-		return collectionResultMsg{items: items, err: err}
+
+		// Progress reports and the result arrive on updates, one per command
+		updates := make(chan tea.Msg, 1)
+		send := func(msg tea.Msg) {
+			select {
+			case updates <- msg:
+			case <-ctx.Done():
+			}
+		}
+		go func() {
+			defer close(updates)
+			opts := bgg.CollectionOptions{
+				Progress: func(p bgg.CollectionProgress) {
+					send(collectionProgressMsg{progress: p, updates: updates})
+				},
+			}
+			items, err := client.GetCollectionContext(ctx, username, opts)
+			if ctx.Err() != nil {
+				return
+			}
+			send(collectionResultMsg{items: items, err: err})
+		}()
+		return waitCollectionUpdate(updates)()
 	}
 }
 
+// waitCollectionUpdate waits for the next message of a collection load.
+func waitCollectionUpdate(updates <-chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return <-updates
+	}
+}
+
+// collectionProgressText describes a pending collection request.
+func collectionProgressText(p bgg.CollectionProgress) string {
+	attempt := fmt.Sprintf("attempt %d", p.Attempt)
+	if p.MaxAttempts > 0 {
+		attempt = fmt.Sprintf("attempt %d/%d", p.Attempt, p.MaxAttempts)
+	}
+	return fmt.Sprintf("BGG is preparing the collection (%s, retrying in %s)", attempt, p.RetryIn.Round(time.Second))
+}
+
+// collectionErrorMessage returns a user-facing message for a collection load error.
+func collectionErrorMessage(err error) string {
+	var invalid *bgg.InvalidUsernameError
+	var processing *bgg.ProcessingError
+	switch {
+	case errors.As(err, &invalid):
+		return fmt.Sprintf("User %q not found on BoardGameGeek", invalid.Username)
+	case errors.As(err, &processing):
+		return "BGG is still preparing this collection. Try again in a moment."
+	}
+	return err.Error()
+}
+
 // applyStatusFilter filters allItems by active statuses and updates filter.items.
 func (m *collectionModel) applyStatusFilter() {
 	if len(m.activeStatuses) == 0 {
@@ -177,6 +241,7 @@ func (m collectionModel) Update(msg tea.Msg, client *bgg.Client) (collectionMode
 				username := strings.TrimSpace(m.input.Value())
 				if username != "" {
 					m.state = collectionStateLoading
+					m.progress = nil
 					return m, m.loadCollection(client, username)
 				}
 			case key.Matches(msg, m.keys.Escape):
@@ -189,10 +254,13 @@ func (m collectionModel) Update(msg tea.Msg, client *bgg.Client) (collectionMode
 
 	case collectionStateLoading:
 		switch msg := msg.(type) {
+		case collectionProgressMsg:
+			m.progress = &msg.progress
+			return m, waitCollectionUpdate(msg.updates)
 		case collectionResultMsg:
 			if msg.err != nil {
 				m.state = collectionStateError
-				m.errMsg = msg.err.Error()
+				m.errMsg = collectionErrorMessage(msg.err)
 			} else {
 				m.state = collectionStateResults
 				m.allItems = msg.items
@@ -306,7 +374,11 @@ func (m collectionModel) View(width, height int, selType string, animFrame int)
 		b.WriteString("\n\n")
 		b.WriteString(m.styles.Loading.Render("Loading collection..."))
 		b.WriteString("\n")
-		b.WriteString(m.styles.Subtitle.Render("(This may take a moment)"))
+		if m.progress != nil {
+			b.WriteString(m.styles.Subtitle.Render(collectionProgressText(*m.progress)))
+		} else {
+			b.WriteString(m.styles.Subtitle.Render("(This may take a moment)"))
+		}
 
 	case collectionStateResults:
 		username := strings.TrimSpace(m.input.Value())
@@ -0,0 +1,63 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	bgg "github.com/hiroaqii/go-bgg"
+	"github.com/hiroaqii/go-bgg/bggtest"
+
+	"github.com/hiroaqii/bgg-tui/internal/config"
+)
+
+func TestCollectionProgress(t *testing.T) {
+	srv := bggtest.NewServer()
+	defer srv.Close()
+	srv.SetCollectionPending(2)
+
+	client, err := bgg.NewClient(srv.Config())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	m := newCollectionModel(config.DefaultConfig(), NewStyles("default"), DefaultKeyMap(), false, nil)
+	m.state = collectionStateLoading
+	msg := m.loadCollection(client, "testuser")()
+
+	for attempt := 1; attempt <= 2; attempt++ {
+		if _, ok := msg.(collectionProgressMsg); !ok {
+			t.Fatalf("attempt %d: expected collectionProgressMsg, got %T", attempt, msg)
+		}
+		var cmd tea.Cmd
+		m, cmd = m.Update(msg, client)
+		if cmd == nil {
+			t.Fatal("expected a command waiting for the next update")
+		}
+		want := fmt.Sprintf("BGG is preparing the collection (attempt %d/11, retrying in 0s)", attempt)
+		if view := m.View(120, 40, "none", 0); !strings.Contains(view, want) {
+			t.Errorf("view does not contain %q:\n%s", want, view)
+		}
+		msg = cmd()
+	}
+
+	m, _ = m.Update(msg, client)
+	if m.state != collectionStateResults || len(m.allItems) != 3 {
+		t.Errorf("expected 3 results, got state %v (%s)", m.state, m.errMsg)
+	}
+}
+
+func TestCollectionProgressText(t *testing.T) {
+	p := bgg.CollectionProgress{Attempt: 3, MaxAttempts: 10, RetryIn: 2 * time.Second}
+	if got, want := collectionProgressText(p), "BGG is preparing the collection (attempt 3/10, retrying in 2s)"; got != want {
+		t.Errorf("collectionProgressText() = %q, want %q", got, want)
+	}
+
+	p.MaxAttempts = 0
+	if got, want := collectionProgressText(p), "BGG is preparing the collection (attempt 3, retrying in 2s)"; got != want {
+		t.Errorf("collectionProgressText() = %q, want %q", got, want)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os/exec"
 	"runtime"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
@@ -53,6 +54,8 @@ type detailModel struct {
 	imgLineStart   int // first line index of image in contentLines (-1 = none)
 	imgLineEnd     int // one past last line index of image in contentLines
 	cache          *imageCache
+
+	loadContext
 }
 
 // detailResultMsg is sent when game details are received.
@@ -72,6 +75,8 @@ func newDetailModel(gameID int, styles Styles, keys KeyMap, imgEnabled bool, cac
 		imgCols:      detailImageCols,
 		imgRows:      detailImageRows,
 		cache:        cache,
+
+		loadContext: newLoadContext(),
 	}
 }
 
@@ -164,6 +169,14 @@ func (m *detailModel) buildContentLines() {
 		lines = append(lines, renderPlayerCountPoll(game.PlayerCountPoll)...)
 	}
 
+	// Suggested age and language dependence polls
+	if ageStr := formatAgePoll(game.AgePoll); ageStr != "" {
+		lines = append(lines, labelLine("Suggested Age", ageStr))
+	}
+	if langStr := formatLanguageDependencePoll(game.LanguageDependencePoll); langStr != "" {
+		lines = append(lines, wrapLabeledText(m.styles.Label.Render("Language"), langStr, m.config.Display.DetailWidth)...)
+	}
+
 	// Playing time
 	timeStr := fmt.Sprintf("%d min", game.PlayingTime)
 	if game.MinPlayTime != game.MaxPlayTime {
@@ -241,11 +254,15 @@ func (m *detailModel) buildContentLines() {
 }
 
 func (m detailModel) loadGame(client *bgg.Client) tea.Cmd {
+	ctx := m.ctx()
 	return func() tea.Msg {
 		if client == nil {
 			return detailResultMsg{err: fmt.Errorf(errNoToken)}
 		}
-		game, err := client.GetGame(m.gameID)
+		game, err := client.GetGameContext(ctx, m.gameID)
+		if ctx.Err() != nil {
+			return nil
+		}
 		return detailResultMsg{game: game, err: err}
 	}
 }
@@ -613,6 +630,46 @@ func renderPlayerCountPoll(poll *bgg.PlayerCountPoll) []string {
 	return lines
 }
 
+// formatAgePoll summarizes the suggested player age poll as the winning age
+// and its share of the votes. Returns "" when there are no votes.
+func formatAgePoll(poll *bgg.AgePoll) string {
+	if poll == nil || poll.Winner == "" {
+		return ""
+	}
+
+	var votes, total int
+	for _, r := range poll.Results {
+		total += r.NumVotes
+		if r.Value == poll.Winner {
+			votes = r.NumVotes
+		}
+	}
+
+	age := poll.Winner
+	if _, err := strconv.Atoi(age); err == nil {
+		age += "+"
+	}
+	return fmt.Sprintf("%s (%d%% of %s votes)", age, votes*100/total, formatNumber(total))
+}
+
+// formatLanguageDependencePoll summarizes the language dependence poll as the
+// winning level and its share of the votes. Returns "" when there are no votes.
+func formatLanguageDependencePoll(poll *bgg.LanguageDependencePoll) string {
+	if poll == nil || poll.Winner == "" {
+		return ""
+	}
+
+	var votes, total int
+	for _, r := range poll.Results {
+		total += r.NumVotes
+		if r.Level == poll.WinnerLevel {
+			votes = r.NumVotes
+		}
+	}
+
+	return fmt.Sprintf("%s (%d%% of %s votes)", poll.Winner, votes*100/total, formatNumber(total))
+}
+
 // openBrowser opens the specified URL in the default browser.
 func openBrowser(url string) {
 	var cmd *exec.Cmd
@@ -3,6 +3,8 @@ package tui
 import (
 	"testing"
 
+	bgg "github.com/hiroaqii/go-bgg"
+
 	"github.com/hiroaqii/bgg-tui/internal/config"
 )
 
@@ -148,3 +150,71 @@ func TestDetailImageConstants(t *testing.T) {
 		t.Errorf("detailImageRows = %d, want 10", detailImageRows)
 	}
 }
+
+func TestFormatAgePoll(t *testing.T) {
+	tests := []struct {
+		name string
+		poll *bgg.AgePoll
+		want string
+	}{
+		{name: "nil poll", poll: nil, want: ""},
+		{name: "no votes", poll: &bgg.AgePoll{Results: []bgg.PollVote{{Value: "8"}}}, want: ""},
+		{
+			name: "numeric winner",
+			poll: &bgg.AgePoll{
+				Results: []bgg.PollVote{{Value: "8", NumVotes: 1}, {Value: "10", NumVotes: 3}},
+				Winner:  "10",
+			},
+			want: "10+ (75% of 4 votes)",
+		},
+		{
+			name: "open-ended winner",
+			poll: &bgg.AgePoll{
+				Results: []bgg.PollVote{{Value: "21 and up", NumVotes: 2}},
+				Winner:  "21 and up",
+			},
+			want: "21 and up (100% of 2 votes)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatAgePoll(tt.poll); got != tt.want {
+				t.Errorf("formatAgePoll() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatLanguageDependencePoll(t *testing.T) {
+	poll := &bgg.LanguageDependencePoll{
+		Results: []bgg.LanguageDependenceVotes{
+			{Level: 1, Description: "No necessary in-game text", NumVotes: 1},
+			{Level: 2, Description: "Some necessary text", NumVotes: 1},
+		},
+		Winner:      "No necessary in-game text",
+		WinnerLevel: 1,
+	}
+
+	want := "No necessary in-game text (50% of 2 votes)"
+	if got := formatLanguageDependencePoll(poll); got != want {
+		t.Errorf("formatLanguageDependencePoll() = %q, want %q", got, want)
+	}
+	if got := formatLanguageDependencePoll(nil); got != "" {
+		t.Errorf("formatLanguageDependencePoll(nil) = %q, want empty", got)
+	}
+}
+
+func TestDetailLoadGameCanceled(t *testing.T) {
+	client, err := bgg.NewClient(bgg.Config{Token: "test-token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := newDetailModel(13, NewStyles("default"), DefaultKeyMap(), false, nil, config.DefaultConfig())
+	m.CancelLoads()
+
+	if msg := m.loadGame(client)(); msg != nil {
+		t.Errorf("expected a cancelled load to produce no message, got %T", msg)
+	}
+}
@@ -0,0 +1,216 @@
+package tui
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	bgg "github.com/hiroaqii/go-bgg"
+	"github.com/hiroaqii/go-bgg/bggtest"
+
+	"github.com/hiroaqii/bgg-tui/internal/config"
+)
+
+// newE2EModel creates an app model talking to a fake BGG server, with
+// images and animations disabled so no timer-driven commands are issued.
+func newE2EModel(t *testing.T, srv *bggtest.Server) Model {
+	t.Helper()
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	cfg := config.DefaultConfig()
+	cfg.API.Token = bggtest.Token
+	cfg.Display.ShowImages = false
+	cfg.Interface.Transition = "none"
+	cfg.Interface.Selection = "none"
+
+	client, err := bgg.NewClient(srv.Config())
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	m, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	m.bggClient = client
+	return drive(t, m, tea.WindowSizeMsg{Width: 120, Height: 40})
+}
+
+// drive feeds msgs to m and keeps running the resulting commands until
+// they settle, like the Bubble Tea runtime would.
+func drive(t *testing.T, m Model, msgs ...tea.Msg) Model {
+	t.Helper()
+
+	queue := msgs
+	for steps := 0; len(queue) > 0; steps++ {
+		if steps > 100 {
+			t.Fatal("update loop did not settle")
+		}
+		msg := queue[0]
+		queue = queue[1:]
+
+		var cmd tea.Cmd
+		var next tea.Model
+		next, cmd = m.Update(msg)
+		m = next.(Model)
+		queue = append(queue, runCmd(cmd)...)
+	}
+	return m
+}
+
+// runCmd runs cmd and returns its messages, flattening batches. Messages
+// from bubbles components (cursor blinks) are dropped.
+func runCmd(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+
+	done := make(chan tea.Msg, 1)
+	go func() { done <- cmd() }()
+
+	var msg tea.Msg
+	select {
+	case msg = <-done:
+	case <-time.After(5 * time.Second):
+		return nil
+	}
+
+	switch msg := msg.(type) {
+	case nil:
+		return nil
+	case tea.BatchMsg:
+		results := make([][]tea.Msg, len(msg))
+		var wg sync.WaitGroup
+		for i, c := range msg {
+			wg.Add(1)
+			go func(i int, c tea.Cmd) {
+				defer wg.Done()
+				results[i] = runCmd(c)
+			}(i, c)
+		}
+		wg.Wait()
+
+		var msgs []tea.Msg
+		for _, r := range results {
+			msgs = append(msgs, r...)
+		}
+		return msgs
+	}
+
+	if strings.HasPrefix(reflect.TypeOf(msg).PkgPath(), "github.com/charmbracelet/bubbles") {
+		return nil
+	}
+	return []tea.Msg{msg}
+}
+
+func keyRunes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+var keyEnter = tea.KeyMsg{Type: tea.KeyEnter}
+
+func TestE2E_HotToThread(t *testing.T) {
+	srv := bggtest.NewServer()
+	defer srv.Close()
+	m := newE2EModel(t, srv)
+
+	m = drive(t, m, keyRunes("1"))
+	if m.currentView != ViewHot || m.hot.state != hotStateResults {
+		t.Fatalf("expected hot results, got view %v state %v (%s)", m.currentView, m.hot.state, m.hot.errMsg)
+	}
+	if len(m.hot.filter.items) != 5 {
+		t.Errorf("expected 5 hot games, got %d", len(m.hot.filter.items))
+	}
+	if !m.hot.statsLoaded {
+		t.Error("expected hot stats to be loaded")
+	}
+
+	m = drive(t, m, keyEnter)
+	if m.currentView != ViewDetail || m.detail.game == nil {
+		t.Fatalf("expected game detail, got view %v (%s)", m.currentView, m.detail.errMsg)
+	}
+	if m.detail.game.Name != "CATAN" {
+		t.Errorf("expected CATAN, got %q", m.detail.game.Name)
+	}
+
+	m = drive(t, m, keyRunes("f"))
+	if m.currentView != ViewForumList || len(m.forum.forums) == 0 {
+		t.Fatalf("expected forum list, got view %v (%s)", m.currentView, m.forum.errMsg)
+	}
+
+	m = drive(t, m, keyEnter)
+	if m.currentView != ViewThreadList || m.forum.threads == nil {
+		t.Fatalf("expected thread list, got view %v (%s)", m.currentView, m.forum.errMsg)
+	}
+
+	m = drive(t, m, keyEnter)
+	if m.currentView != ViewThreadView || m.thread.thread == nil {
+		t.Fatalf("expected thread view, got view %v (%s)", m.currentView, m.thread.errMsg)
+	}
+	if len(m.thread.thread.Articles) == 0 {
+		t.Error("expected thread articles")
+	}
+}
+
+func TestE2E_Collection(t *testing.T) {
+	srv := bggtest.NewServer()
+	defer srv.Close()
+	m := newE2EModel(t, srv)
+
+	m = drive(t, m, keyRunes("3"))
+	if m.currentView != ViewCollectionInput {
+		t.Fatalf("expected collection input, got view %v", m.currentView)
+	}
+
+	m = drive(t, m, keyRunes("testuser"), keyEnter)
+	if m.currentView != ViewCollectionList || m.collection.state != collectionStateResults {
+		t.Fatalf("expected collection results, got view %v state %v (%s)", m.currentView, m.collection.state, m.collection.errMsg)
+	}
+	if len(m.collection.filter.items) != 3 {
+		t.Errorf("expected 3 collection items, got %d", len(m.collection.filter.items))
+	}
+	if n := srv.RequestCount("/collection"); n != 2 {
+		t.Errorf("expected 202 then 200 (2 requests), got %d", n)
+	}
+}
+
+func TestE2E_HotError(t *testing.T) {
+	srv := bggtest.NewServer()
+	defer srv.Close()
+	m := newE2EModel(t, srv)
+
+	srv.Fail("/hot", http.StatusServiceUnavailable)
+	m = drive(t, m, keyRunes("1"))
+	if m.hot.state != hotStateError {
+		t.Fatalf("expected hot error state, got %v", m.hot.state)
+	}
+
+	// Retrying after BGG recovers shows the list
+	srv.Reset()
+	m = drive(t, m, keyEnter)
+	if m.hot.state != hotStateResults {
+		t.Errorf("expected hot results after retry, got %v (%s)", m.hot.state, m.hot.errMsg)
+	}
+}
+
+func TestE2E_CollectionInvalidUser(t *testing.T) {
+	srv := bggtest.NewServer()
+	defer srv.Close()
+	m := newE2EModel(t, srv)
+
+	srv.SetCollectionPending(0)
+	srv.SetResponse("/collection", []byte(`<errors><error><message>Invalid username specified</message></error></errors>`))
+	m = drive(t, m, keyRunes("3"), keyRunes("nosuchuser"), keyEnter)
+	if m.collection.state != collectionStateError {
+		t.Fatalf("expected collection error state, got %v", m.collection.state)
+	}
+	if want := `User "nosuchuser" not found on BoardGameGeek`; m.collection.errMsg != want {
+		t.Errorf("errMsg = %q, want %q", m.collection.errMsg, want)
+	}
+}
@@ -43,6 +43,8 @@ type forumModel struct {
 	wantsBack          bool
 	wantsMenu          bool
 	wantsThread        *int // Selected thread ID
+
+	loadContext
 }
 
 // forumsResultMsg is sent when forums are received.
@@ -66,25 +68,35 @@ func newForumModel(gameID int, gameName string, styles Styles, keys KeyMap, cfg
 		gameID:   gameID,
 		gameName: gameName,
 		page:     1,
+
+		loadContext: newLoadContext(),
 	}
 }
 
 func (m forumModel) loadForums(client *bgg.Client) tea.Cmd {
+	ctx := m.ctx()
 	return func() tea.Msg {
 		if client == nil {
 			return forumsResultMsg{err: fmt.Errorf(errNoToken)}
 		}
-		forums, err := client.GetForums(m.gameID)
+		forums, err := client.GetForumsContext(ctx, m.gameID)
+		if ctx.Err() != nil {
+			return nil
+		}
 		return forumsResultMsg{forums: forums, err: err}
 	}
 }
 
 func (m forumModel) loadThreads(client *bgg.Client) tea.Cmd {
+	ctx := m.ctx()
 	return func() tea.Msg {
 		if client == nil {
 			return threadsResultMsg{err: fmt.Errorf(errNoToken)}
 		}
-		threads, err := client.GetForumThreads(m.selectedForumID, m.page)
+		threads, err := client.GetForumThreadsContext(ctx, m.selectedForumID, m.page)
+		if ctx.Err() != nil {
+			return nil
+		}
 		return threadsResultMsg{threads: threads, err: err}
 	}
 }
@@ -102,7 +114,7 @@ func (m forumModel) Update(msg tea.Msg, client *bgg.Client) (forumModel, tea.Cmd
 				m.forums = msg.forums
 				// Sort by last post date (descending)
 				sort.Slice(m.forums, func(i, j int) bool {
-					return parseDate(m.forums[i].LastPostDate).After(parseDate(m.forums[j].LastPostDate))
+					return m.forums[i].LastPostTime.After(m.forums[j].LastPostTime)
 				})
 				m.forumCursor = 0
 			}
@@ -271,7 +283,7 @@ func (m forumModel) View(width, height int, selType string, animFrame int) strin
 
 				// Second line: author, date, replies
 				meta := fmt.Sprintf("    %s · %s · %d replies",
-					formatDate(thread.LastPostDate, m.config.Interface.DateFormat),
+					formatTime(thread.LastPostTime, thread.LastPostDate, m.config.Interface.DateFormat),
 					thread.Author,
 					thread.NumArticles-1)
 				b.WriteString(lipgloss.NewStyle().Foreground(ColorDim).Render(meta))
@@ -313,27 +325,11 @@ func formatForumColumns(forums []bgg.Forum, dateFormat string) (titles []string,
 
 	for _, f := range forums {
 		titles = append(titles, fmt.Sprintf("%-*s", maxTitleWidth, f.Title))
-		metas = append(metas, fmt.Sprintf("%*d threads · %s", maxDigits, f.NumThreads, formatDate(f.LastPostDate, dateFormat)))
+		metas = append(metas, fmt.Sprintf("%*d threads · %s", maxDigits, f.NumThreads, formatTime(f.LastPostTime, f.LastPostDate, dateFormat)))
 	}
 	return
 }
 
-// parseDate parses a date string into time.Time.
-// Returns zero time if parsing fails.
-func parseDate(dateStr string) time.Time {
-	formats := []string{
-		time.RFC1123Z, // "Mon, 02 Jan 2006 15:04:05 -0700"
-		time.RFC1123,  // "Mon, 02 Jan 2006 15:04:05 MST"
-		time.RFC3339,  // "2006-01-02T15:04:05Z07:00"
-	}
-	for _, layout := range formats {
-		if t, err := time.Parse(layout, dateStr); err == nil {
-			return t
-		}
-	}
-	return time.Time{}
-}
-
 // DateFormatNames lists the available date format options.
 var DateFormatNames = []string{"YYYY-MM-DD", "MM/DD/YYYY", "DD/MM/YYYY"}
 
@@ -349,14 +345,11 @@ func dateLayout(format string) string {
 	}
 }
 
-// formatDate formats a date string for display using the given format.
-func formatDate(dateStr, format string) string {
-	if dateStr == "" {
-		return ""
-	}
-	t := parseDate(dateStr)
+// formatTime formats a date parsed by go-bgg for display using the given
+// format, falling back to the raw string if BGG sent an unknown format.
+func formatTime(t time.Time, raw, format string) string {
 	if t.IsZero() {
-		return dateStr
+		return raw
 	}
 	return t.Format(dateLayout(format))
 }
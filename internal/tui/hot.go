@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -38,6 +39,8 @@ type hotModel struct {
 	statsLoaded bool
 
 	img listImageState
+
+	loadContext
 }
 
 func (m *hotModel) WantsMenu() bool  { return m.wantsMenu }
@@ -68,35 +71,37 @@ func newHotModel(cfg *config.Config, styles Styles, keys KeyMap, imageEnabled bo
 			getName: func(g bgg.HotGame) string { return g.Name },
 			getID:   func(g bgg.HotGame) int { return g.ID },
 		},
+
+		loadContext: newLoadContext(),
 	}
 }
 
-func (m hotModel) loadHotGames(client *bgg.Client) tea.Cmd {
+// loadHotGames fetches the hot list. If refresh is set the response cache
+// is bypassed.
+func (m hotModel) loadHotGames(client *bgg.Client, refresh bool) tea.Cmd {
+	ctx := m.ctx()
+	if refresh {
+		ctx = bgg.WithNoCache(ctx)
+	}
 	return func() tea.Msg {
 		if client == nil {
 			return hotResultMsg{err: fmt.Errorf(errNoToken)}
 		}
-		games, err := client.GetHotGames()
+		games, err := client.GetHotGamesContext(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
 		return hotResultMsg{games: games, err: err}
 	}
 }
 
-func loadHotStats(client *bgg.Client, ids []int) tea.Cmd {
+func loadHotStats(ctx context.Context, client *bgg.Client, ids []int) tea.Cmd {
 	return func() tea.Msg {
-		var allGames []bgg.Game
-		// Split into batches of 20 (API limit)
-		for i := 0; i < len(ids); i += 20 {
-			end := i + 20
-			if end > len(ids) {
-				end = len(ids)
-			}
-			games, err := client.GetGames(ids[i:end])
-			if err != nil {
-				return hotStatsMsg{err: err}
-			}
-			allGames = append(allGames, games...)
+		games, err := client.GetGamesAll(ctx, ids, bgg.BatchOptions{})
+		if ctx.Err() != nil {
+			return nil
 		}
-		return hotStatsMsg{games: allGames}
+		return hotStatsMsg{games: games, err: err}
 	}
 }
 
@@ -133,7 +138,7 @@ func (m hotModel) Update(msg tea.Msg, client *bgg.Client) (hotModel, tea.Cmd) {
 				for i, g := range msg.games {
 					ids[i] = g.ID
 				}
-				statsCmd := loadHotStats(client, ids)
+				statsCmd := loadHotStats(m.ctx(), client, ids)
 				return m, tea.Batch(thumbCmd, statsCmd)
 			}
 		}
@@ -148,11 +153,10 @@ func (m hotModel) Update(msg tea.Msg, client *bgg.Client) (hotModel, tea.Cmd) {
 
 		// Handle stats loaded
 		if msg, ok := msg.(hotStatsMsg); ok {
-			if msg.err == nil {
-				m.stats = make(map[int]bgg.Game, len(msg.games))
-				for _, g := range msg.games {
-					m.stats[g.ID] = g
-				}
+			// Keep partial stats when some batches failed
+			m.stats = make(map[int]bgg.Game, len(msg.games))
+			for _, g := range msg.games {
+				m.stats[g.ID] = g
 			}
 			m.statsLoaded = true
 			return m, nil
@@ -195,7 +199,7 @@ func (m hotModel) Update(msg tea.Msg, client *bgg.Client) (hotModel, tea.Cmd) {
 				m.filter.cursor = 0
 				m.stats = nil
 				m.statsLoaded = false
-				return m, m.loadHotGames(client)
+				return m, m.loadHotGames(client, true)
 			case key.Matches(msg, m.keys.Back):
 				m.wantsBack = true
 			case key.Matches(msg, m.keys.Escape):
@@ -211,7 +215,7 @@ func (m hotModel) Update(msg tea.Msg, client *bgg.Client) (hotModel, tea.Cmd) {
 			case key.Matches(msg, m.keys.Enter), key.Matches(msg, m.keys.Refresh):
 				m.state = hotStateLoading
 				m.errMsg = ""
-				return m, m.loadHotGames(client)
+				return m, m.loadHotGames(client, true)
 			case key.Matches(msg, m.keys.Back):
 				m.wantsBack = true
 			case key.Matches(msg, m.keys.Escape):
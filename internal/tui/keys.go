@@ -24,6 +24,7 @@ type KeyMap struct {
 	Filter       key.Binding
 	Sort         key.Binding
 	StatusFilter key.Binding
+	SearchType   key.Binding
 }
 
 // DefaultKeyMap returns the default key bindings.
@@ -109,6 +110,10 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("s"),
 			key.WithHelp("s", "status filter"),
 		),
+		SearchType: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "search type"),
+		),
 	}
 }
 
@@ -1,12 +1,14 @@
 package tui
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 
 	bgg "github.com/hiroaqii/go-bgg"
 
@@ -24,6 +26,19 @@ const (
 	searchStateError
 )
 
+// searchTypeOption is a selectable search scope on the search input screen.
+type searchTypeOption struct {
+	label string
+	types []bgg.ThingType // nil searches all types
+}
+
+var searchTypeOptions = []searchTypeOption{
+	{label: "Board Games", types: []bgg.ThingType{bgg.ThingBoardGame, bgg.ThingBoardGameExpansion}},
+	{label: "RPG Items", types: []bgg.ThingType{bgg.ThingRPGItem}},
+	{label: "Video Games", types: []bgg.ThingType{bgg.ThingVideoGame}},
+	{label: "All", types: nil},
+}
+
 type searchModel struct {
 	state    searchState
 	config   *config.Config
@@ -32,6 +47,7 @@ type searchModel struct {
 	input    textinput.Model
 	errMsg   string
 	selected *int // Selected game ID for detail view
+	typeIdx  int  // index into searchTypeOptions
 
 	filter filterState[bgg.GameSearchResult]
 
@@ -41,6 +57,8 @@ type searchModel struct {
 	img        listImageState
 	lastGameID int            // last loaded game ID (tracked by ID since search results lack thumb URLs)
 	thumbURLs  map[int]string // gameID → thumbnail URL cache
+
+	loadContext
 }
 
 func (m *searchModel) WantsMenu() bool  { return m.wantsMenu }
@@ -65,7 +83,7 @@ type searchThumbMsg struct {
 
 func newSearchModel(cfg *config.Config, styles Styles, keys KeyMap, imageEnabled bool, cache *imageCache) searchModel {
 	ti := textinput.New()
-	ti.Placeholder = "Enter game name..."
+	ti.Placeholder = "Enter name..."
 	ti.CharLimit = 100
 	ti.Width = 40
 	ti.Focus()
@@ -82,15 +100,21 @@ func newSearchModel(cfg *config.Config, styles Styles, keys KeyMap, imageEnabled
 			getName: func(r bgg.GameSearchResult) string { return r.Name },
 			getID:   func(r bgg.GameSearchResult) int { return r.ID },
 		},
+
+		loadContext: newLoadContext(),
 	}
 }
 
 func (m searchModel) doSearch(client *bgg.Client, query string) tea.Cmd {
+	ctx := m.ctx()
 	return func() tea.Msg {
 		if client == nil {
 			return searchResultMsg{err: fmt.Errorf(errNoToken)}
 		}
-		results, err := client.SearchGames(query)
+		results, err := client.SearchContext(ctx, query, bgg.SearchOptions{Types: searchTypeOptions[m.typeIdx].types})
+		if ctx.Err() != nil {
+			return nil
+		}
 		return searchResultMsg{results: results, err: err}
 	}
 }
@@ -123,16 +147,19 @@ func (m searchModel) maybeLoadThumb(client *bgg.Client) (searchModel, tea.Cmd) {
 	}
 
 	// Otherwise fetch the thumb URL via GetGame
-	return m, loadSearchThumb(client, m.img.cache, gameID)
+	return m, loadSearchThumb(m.ctx(), client, m.img.cache, gameID)
 }
 
 // loadSearchThumb fetches thumbnail URL via GetGame, downloads and renders the image.
-func loadSearchThumb(client *bgg.Client, cache *imageCache, gameID int) tea.Cmd {
+func loadSearchThumb(ctx context.Context, client *bgg.Client, cache *imageCache, gameID int) tea.Cmd {
 	return func() tea.Msg {
 		if client == nil {
 			return searchThumbMsg{gameID: gameID, err: fmt.Errorf("no client")}
 		}
-		game, err := client.GetGame(gameID)
+		game, err := client.GetGameContext(ctx, gameID)
+		if ctx.Err() != nil {
+			return nil
+		}
 		if err != nil {
 			return searchThumbMsg{gameID: gameID, err: err}
 		}
@@ -167,6 +194,9 @@ func (m searchModel) Update(msg tea.Msg, client *bgg.Client) (searchModel, tea.C
 					m.state = searchStateLoading
 					return m, m.doSearch(client, query)
 				}
+			case key.Matches(msg, m.keys.SearchType):
+				m.typeIdx = (m.typeIdx + 1) % len(searchTypeOptions)
+				return m, nil
 			case key.Matches(msg, m.keys.Escape):
 				m.wantsMenu = true
 				return m, nil
@@ -290,6 +320,36 @@ func (m searchModel) Update(msg tea.Msg, client *bgg.Client) (searchModel, tea.C
 	return m, nil
 }
 
+// renderSearchTypeBar renders all search types with the selected one highlighted.
+func (m searchModel) renderSearchTypeBar() string {
+	activeStyle := lipgloss.NewStyle().Foreground(ColorAccent).Italic(true)
+	dimStyle := lipgloss.NewStyle().Foreground(ColorDim)
+	var parts []string
+	for i, opt := range searchTypeOptions {
+		if i == m.typeIdx {
+			parts = append(parts, activeStyle.Render(opt.label))
+		} else {
+			parts = append(parts, dimStyle.Render(opt.label))
+		}
+	}
+	return "[" + strings.Join(parts, dimStyle.Render(", ")) + "]"
+}
+
+// searchTypeIndicator returns the suffix shown after non-board-game results.
+func searchTypeIndicator(thingType string) string {
+	switch bgg.ThingType(thingType) {
+	case bgg.ThingBoardGameExpansion:
+		return " [Expansion]"
+	case bgg.ThingBoardGameAccessory:
+		return " [Accessory]"
+	case bgg.ThingRPGItem:
+		return " [RPG]"
+	case bgg.ThingVideoGame:
+		return " [Video]"
+	}
+	return ""
+}
+
 func (m searchModel) View(width, height int, selType string, animFrame int) string {
 	var b strings.Builder
 	var transmit string
@@ -298,10 +358,13 @@ func (m searchModel) View(width, height int, selType string, animFrame int) stri
 	case searchStateInput:
 		b.WriteString(m.styles.Title.Render("Search Games"))
 		b.WriteString("\n\n")
-		b.WriteString("Enter game name:\n")
+		b.WriteString("Type: ")
+		b.WriteString(m.renderSearchTypeBar())
+		b.WriteString("\n\n")
+		b.WriteString("Enter name:\n")
 		b.WriteString(m.input.View())
 		b.WriteString("\n\n")
-		b.WriteString(m.styles.Help.Render("Enter: Search (3+ chars)  Esc: Menu"))
+		b.WriteString(m.styles.Help.Render("Enter: Search (3+ chars)  Tab: Type  Esc: Menu"))
 
 	case searchStateLoading:
 		writeLoadingView(&b, m.styles, "Search Games", "Searching...")
@@ -344,10 +407,7 @@ func (m searchModel) View(width, height int, selType string, animFrame int) stri
 					year = "N/A"
 				}
 
-				typeIndicator := ""
-				if result.Type == "boardgameexpansion" {
-					typeIndicator = " [Expansion]"
-				}
+				typeIndicator := searchTypeIndicator(result.Type)
 
 				displayName := truncateName(result.Name, maxNameW)
 				prefix, name := renderListItem(i, m.filter.cursor, displayName, m.styles, selType, animFrame)
@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/hiroaqii/bgg-tui/internal/config"
+)
+
+func TestSearchTypeCycle(t *testing.T) {
+	m := newSearchModel(config.DefaultConfig(), NewStyles("default"), DefaultKeyMap(), false, nil)
+
+	for i := 1; i <= len(searchTypeOptions); i++ {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}, nil)
+		want := i % len(searchTypeOptions)
+		if m.typeIdx != want {
+			t.Errorf("after %d tabs typeIdx = %d, want %d", i, m.typeIdx, want)
+		}
+	}
+
+	if m.input.Value() != "" {
+		t.Errorf("tab should not be typed into the input, got %q", m.input.Value())
+	}
+}
+
+func TestSearchTypeIndicator(t *testing.T) {
+	tests := []struct {
+		thingType string
+		want      string
+	}{
+		{"boardgame", ""},
+		{"boardgameexpansion", " [Expansion]"},
+		{"rpgitem", " [RPG]"},
+		{"videogame", " [Video]"},
+		{"unknown", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.thingType, func(t *testing.T) {
+			if got := searchTypeIndicator(tt.thingType); got != tt.want {
+				t.Errorf("searchTypeIndicator(%q) = %q, want %q", tt.thingType, got, tt.want)
+			}
+		})
+	}
+}
@@ -37,14 +37,29 @@ type threadModel struct {
 	errMsg     string
 	wantsBack  bool
 	wantsMenu  bool
+
+	lastArticleID int    // highest article ID loaded so far
+	loadingMore   bool   // a follow-up page is being fetched
+	moreErrMsg    string // error from the last follow-up fetch
+
+	loadContext
 }
 
+// threadPageSize is the number of articles fetched per request.
+const threadPageSize = 50
+
 // threadResultMsg is sent when thread content is received.
 type threadResultMsg struct {
 	thread *bgg.Thread
 	err    error
 }
 
+// threadMoreMsg is sent when a follow-up page of articles is received.
+type threadMoreMsg struct {
+	thread *bgg.Thread
+	err    error
+}
+
 func newThreadModel(threadID int, styles Styles, keys KeyMap, cfg *config.Config, viewHeight int) threadModel {
 	return threadModel{
 		state:      threadStateLoading,
@@ -53,6 +68,8 @@ func newThreadModel(threadID int, styles Styles, keys KeyMap, cfg *config.Config
 		config:     cfg,
 		threadID:   threadID,
 		viewHeight: viewHeight,
+
+		loadContext: newLoadContext(),
 	}
 }
 
@@ -81,16 +98,73 @@ func (m *threadModel) recalcScroll() {
 }
 
 func (m threadModel) loadThread(client *bgg.Client) tea.Cmd {
+	ctx := m.ctx()
 	return func() tea.Msg {
 		if client == nil {
 			return threadResultMsg{err: fmt.Errorf(errNoToken)}
 		}
-		thread, err := client.GetThread(m.threadID)
+		thread, err := client.GetThreadWithOptionsContext(ctx, m.threadID, bgg.ThreadOptions{Count: threadPageSize})
+		if ctx.Err() != nil {
+			return nil
+		}
 		return threadResultMsg{thread: thread, err: err}
 	}
 }
 
-func (m threadModel) Update(msg tea.Msg) (threadModel, tea.Cmd) {
+// loadMore fetches the articles following the last one loaded.
+func (m threadModel) loadMore(client *bgg.Client) tea.Cmd {
+	ctx := m.ctx()
+	minID := m.lastArticleID + 1
+	return func() tea.Msg {
+		if client == nil {
+			return threadMoreMsg{err: fmt.Errorf(errNoToken)}
+		}
+		thread, err := client.GetThreadWithOptionsContext(ctx, m.threadID, bgg.ThreadOptions{
+			MinArticleID: minID,
+			Count:        threadPageSize,
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+		return threadMoreMsg{thread: thread, err: err}
+	}
+}
+
+// maybeLoadMore starts fetching the next page once the viewport is within
+// one screen of the end of the loaded articles.
+func (m threadModel) maybeLoadMore(client *bgg.Client) (threadModel, tea.Cmd) {
+	if m.thread == nil || !m.thread.HasMore || m.loadingMore || m.moreErrMsg != "" {
+		return m, nil
+	}
+	if m.scroll < m.maxScroll-m.visibleLines() {
+		return m, nil
+	}
+	m.loadingMore = true
+	return m, m.loadMore(client)
+}
+
+// trackLastArticle records the highest article ID in articles.
+func (m *threadModel) trackLastArticle(articles []bgg.Article) {
+	for _, a := range articles {
+		if a.ID > m.lastArticleID {
+			m.lastArticleID = a.ID
+		}
+	}
+}
+
+// sortArticles orders the loaded articles by post date per sortNewest.
+func (m *threadModel) sortArticles() {
+	sort.SliceStable(m.thread.Articles, func(i, j int) bool {
+		ti := m.thread.Articles[i].PostTime
+		tj := m.thread.Articles[j].PostTime
+		if m.sortNewest {
+			return ti.After(tj)
+		}
+		return ti.Before(tj)
+	})
+}
+
+func (m threadModel) Update(msg tea.Msg, client *bgg.Client) (threadModel, tea.Cmd) {
 	switch m.state {
 	case threadStateLoading:
 		switch msg := msg.(type) {
@@ -104,10 +178,12 @@ func (m threadModel) Update(msg tea.Msg) (threadModel, tea.Cmd) {
 				m.state = threadStateResults
 				m.thread = msg.thread
 				m.scroll = 0
+				m.trackLastArticle(m.thread.Articles)
 
 				// Pre-render view lines
 				m.viewLines = m.renderArticles()
 				m.recalcScroll()
+				return m.maybeLoadMore(client)
 			}
 		}
 		return m, nil
@@ -117,6 +193,21 @@ func (m threadModel) Update(msg tea.Msg) (threadModel, tea.Cmd) {
 		case tea.WindowSizeMsg:
 			m.viewHeight = msg.Height
 			m.recalcScroll()
+		case threadMoreMsg:
+			m.loadingMore = false
+			if msg.err != nil {
+				m.moreErrMsg = msg.err.Error()
+				return m, nil
+			}
+			m.thread.HasMore = msg.thread.HasMore && len(msg.thread.Articles) > 0
+			m.thread.Articles = append(m.thread.Articles, msg.thread.Articles...)
+			m.trackLastArticle(msg.thread.Articles)
+			if m.sortNewest {
+				m.sortArticles()
+			}
+			m.viewLines = m.renderArticles()
+			m.recalcScroll()
+			return m.maybeLoadMore(client)
 		case tea.KeyMsg:
 			switch {
 			case key.Matches(msg, m.keys.Up):
@@ -127,20 +218,16 @@ func (m threadModel) Update(msg tea.Msg) (threadModel, tea.Cmd) {
 				if m.scroll < m.maxScroll {
 					m.scroll++
 				}
+				// Scrolling again retries a failed follow-up fetch
+				m.moreErrMsg = ""
+				return m.maybeLoadMore(client)
 			case key.Matches(msg, m.keys.Open):
 				// Open in browser
 				url := fmt.Sprintf("https://boardgamegeek.com/thread/%d", m.threadID)
 				openBrowser(url)
 			case key.Matches(msg, m.keys.Sort):
 				m.sortNewest = !m.sortNewest
-				sort.Slice(m.thread.Articles, func(i, j int) bool {
-					ti := parseDate(m.thread.Articles[i].PostDate)
-					tj := parseDate(m.thread.Articles[j].PostDate)
-					if m.sortNewest {
-						return ti.After(tj)
-					}
-					return ti.Before(tj)
-				})
+				m.sortArticles()
 				m.viewLines = m.renderArticles()
 				m.scroll = 0
 				m.recalcScroll()
@@ -186,7 +273,11 @@ func (m threadModel) View(width, height int) string {
 		if m.sortNewest {
 			sortLabel = "↓New"
 		}
-		b.WriteString(m.styles.Subtitle.Render(fmt.Sprintf("%d posts · %s", len(m.thread.Articles), sortLabel)))
+		postsLabel := fmt.Sprintf("%d posts", len(m.thread.Articles))
+		if m.thread.HasMore {
+			postsLabel = fmt.Sprintf("%d/%d posts", len(m.thread.Articles), m.thread.NumArticles)
+		}
+		b.WriteString(m.styles.Subtitle.Render(fmt.Sprintf("%s · %s", postsLabel, sortLabel)))
 		b.WriteString("\n\n")
 
 		// Show articles with scrolling
@@ -205,6 +296,13 @@ func (m threadModel) View(width, height int) string {
 			b.WriteString("\n")
 			b.WriteString(m.styles.Subtitle.Render(fmt.Sprintf("(%d/%d)", m.scroll+1, m.maxScroll+1)))
 		}
+		if m.loadingMore {
+			b.WriteString("  ")
+			b.WriteString(m.styles.Loading.Render("Loading more posts..."))
+		} else if m.moreErrMsg != "" {
+			b.WriteString("  ")
+			b.WriteString(m.styles.Error.Render("Failed to load more posts: " + m.moreErrMsg + " (j/↓ to retry)"))
+		}
 
 		b.WriteString("\n")
 		b.WriteString(m.styles.Help.Render("j/k ↑↓: Scroll  s: Sort  o: Open BGG  b: Back  Esc: Menu"))
@@ -229,7 +327,7 @@ func (m threadModel) renderArticles() []string {
 		// Header line
 		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(ColorAccent)
 		dateStyle := lipgloss.NewStyle().Foreground(ColorMuted)
-		header := fmt.Sprintf("%s  %s", nameStyle.Render("■ "+article.Username), dateStyle.Render(formatDate(article.PostDate, m.config.Interface.DateFormat)))
+		header := fmt.Sprintf("%s  %s", nameStyle.Render("■ "+article.Username), dateStyle.Render(formatTime(article.PostTime, article.PostDate, m.config.Interface.DateFormat)))
 		lines = append(lines, header)
 
 		// Body lines (wrap text)
@@ -0,0 +1,78 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	bgg "github.com/hiroaqii/go-bgg"
+
+	"github.com/hiroaqii/bgg-tui/internal/config"
+)
+
+func TestThreadLoadMore(t *testing.T) {
+	m := newThreadModel(1, NewStyles("default"), DefaultKeyMap(), config.DefaultConfig(), 40)
+
+	first := &bgg.Thread{
+		ID:          1,
+		NumArticles: 3,
+		HasMore:     true,
+		Articles: []bgg.Article{
+			{ID: 10, Username: "alice", PostDate: "2024-01-01T10:00:00+00:00", Body: "first"},
+			{ID: 11, Username: "bob", PostDate: "2024-01-02T10:00:00+00:00", Body: "second"},
+		},
+	}
+	m, cmd := m.Update(threadResultMsg{thread: first}, nil)
+	if !m.loadingMore || cmd == nil {
+		t.Fatal("expected a follow-up fetch when the whole page fits on screen")
+	}
+	if m.lastArticleID != 11 {
+		t.Errorf("lastArticleID = %d, want 11", m.lastArticleID)
+	}
+
+	more := &bgg.Thread{
+		ID:          1,
+		NumArticles: 3,
+		Articles: []bgg.Article{
+			{ID: 12, Username: "carol", PostDate: "2024-01-03T10:00:00+00:00", Body: "third"},
+		},
+	}
+	m, cmd = m.Update(threadMoreMsg{thread: more}, nil)
+	if cmd != nil || m.loadingMore {
+		t.Error("expected no further fetch once the thread is complete")
+	}
+	if len(m.thread.Articles) != 3 || m.thread.Articles[2].ID != 12 {
+		t.Errorf("expected appended article 12, got %+v", m.thread.Articles)
+	}
+	if m.thread.HasMore {
+		t.Error("expected HasMore to be false")
+	}
+}
+
+func TestThreadLoadMore_RetryAfterError(t *testing.T) {
+	m := newThreadModel(1, NewStyles("default"), DefaultKeyMap(), config.DefaultConfig(), 40)
+
+	first := &bgg.Thread{
+		ID:          1,
+		NumArticles: 3,
+		HasMore:     true,
+		Articles: []bgg.Article{
+			{ID: 10, Username: "alice", PostDate: "2024-01-01T10:00:00+00:00", Body: "first"},
+		},
+	}
+	m, _ = m.Update(threadResultMsg{thread: first}, nil)
+	m, _ = m.Update(threadMoreMsg{err: errors.New("rate limited")}, nil)
+	if m.loadingMore || m.moreErrMsg == "" {
+		t.Fatalf("expected a follow-up error, got loadingMore=%v moreErrMsg=%q", m.loadingMore, m.moreErrMsg)
+	}
+
+	// Scrolling down again retries the fetch
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyDown}, nil)
+	if !m.loadingMore || cmd == nil {
+		t.Error("expected scrolling to retry the follow-up fetch")
+	}
+	if m.moreErrMsg != "" {
+		t.Errorf("expected the error to be cleared, got %q", m.moreErrMsg)
+	}
+}
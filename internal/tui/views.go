@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -190,12 +191,41 @@ func renderListItem(index, cursor int, text string, styles Styles, selType strin
 	return "  ", styles.ListItem.Render(text)
 }
 
+// loadContext scopes a sub-model's API requests so they can be cancelled
+// once the user leaves its view. Loaders return a nil message for a
+// cancelled request so its result never reaches a newer view.
+type loadContext struct {
+	loadCtx context.Context
+	cancel  context.CancelFunc
+}
+
+func newLoadContext() loadContext {
+	ctx, cancel := context.WithCancel(context.Background())
+	return loadContext{loadCtx: ctx, cancel: cancel}
+}
+
+// ctx returns the context for the sub-model's requests.
+func (l loadContext) ctx() context.Context {
+	if l.loadCtx == nil {
+		return context.Background()
+	}
+	return l.loadCtx
+}
+
+// CancelLoads aborts the sub-model's in-flight requests.
+func (l loadContext) CancelLoads() {
+	if l.cancel != nil {
+		l.cancel()
+	}
+}
+
 // listNavigator provides navigation signals from list sub-models.
 type listNavigator interface {
 	WantsMenu() bool
 	WantsBack() bool
 	Selected() *int
 	ClearSignals()
+	CancelLoads()
 }
 
 // handleListNav processes common navigation signals (menu, back, detail selection).
@@ -203,6 +233,7 @@ type listNavigator interface {
 func (m *Model) handleListNav(nav listNavigator, backView View) (bool, tea.Cmd) {
 	if nav.WantsMenu() {
 		nav.ClearSignals()
+		nav.CancelLoads()
 		m.setView(ViewMenu)
 		if m.imageEnabled {
 			m.needsClearImages = true
@@ -212,6 +243,7 @@ func (m *Model) handleListNav(nav listNavigator, backView View) (bool, tea.Cmd)
 
 	if nav.WantsBack() {
 		nav.ClearSignals()
+		nav.CancelLoads()
 		m.setView(ViewMenu)
 		if m.imageEnabled {
 			m.needsClearImages = true